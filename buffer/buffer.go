@@ -0,0 +1,299 @@
+// Package buffer computes the area within a distance of a geometry
+// assuming it is in 2d euclidean space.
+package buffer
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+	"github.com/paulmach/orb/planar"
+)
+
+// Geometry returns the area within the distance of the geometry.
+// Points and lines are only buffered for positive distances. For areas,
+// i.e. rings, polygons and bounds, a negative distance will shrink the area,
+// removing the parts that are narrower than twice the distance.
+// The result will have outer rings in counter-clockwise order and holes
+// in clockwise order.
+func Geometry(g orb.Geometry, distance float64, opts ...Option) orb.MultiPolygon {
+	o := defaultOptions()
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	b := &builder{opts: o, distance: math.Abs(distance)}
+	var areas orb.Collection
+	b.collect(g, distance > 0, &areas)
+
+	area := planar.Union(areas, nil)
+	if distance == 0 {
+		return area
+	}
+
+	// buffer the boundary of the dissolved area so the rings of
+	// overlapping polygons are not included.
+	for _, p := range area {
+		for _, r := range p {
+			b.ring(r)
+		}
+	}
+
+	if distance < 0 {
+		return planar.Difference(area, b.pieces)
+	}
+
+	return planar.Union(area, b.pieces)
+}
+
+// builder collects the pieces that are unioned to create the buffer.
+// Every segment is buffered by a rectangle with the caps and joins added
+// as separate polygons. The union of these is the buffer of the linework.
+type builder struct {
+	opts     *options
+	distance float64
+	pieces   orb.MultiPolygon
+}
+
+func (b *builder) collect(g orb.Geometry, positive bool, areas *orb.Collection) {
+	if g == nil {
+		return
+	}
+
+	switch g := g.(type) {
+	case orb.Point:
+		if positive {
+			b.point(g)
+		}
+	case orb.MultiPoint:
+		if positive {
+			for _, p := range g {
+				b.point(p)
+			}
+		}
+	case orb.LineString:
+		if positive {
+			b.lineString(g)
+		}
+	case orb.MultiLineString:
+		if positive {
+			for _, ls := range g {
+				b.lineString(ls)
+			}
+		}
+	case orb.Ring, orb.Polygon, orb.MultiPolygon, orb.Bound:
+		*areas = append(*areas, g)
+	case orb.Collection:
+		for _, c := range g {
+			b.collect(c, positive, areas)
+		}
+	default:
+		if zm.LayoutOf(g) != zm.XY {
+			b.collect(zm.ToXY(g), positive, areas)
+			return
+		}
+
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+func (b *builder) add(r orb.Ring) {
+	if len(r) < 3 {
+		return
+	}
+
+	if r[0] != r[len(r)-1] {
+		r = append(r, r[0])
+	}
+
+	b.pieces = append(b.pieces, orb.Polygon{r})
+}
+
+func (b *builder) point(p orb.Point) {
+	d := b.distance
+	switch b.opts.capStyle {
+	case CapRound:
+		r := orb.Ring(b.arc(p, orb.Point{d, 0}, 2*math.Pi))
+		b.add(r[:len(r)-1])
+	case CapSquare:
+		b.add(orb.Bound{
+			Min: orb.Point{p[0] - d, p[1] - d},
+			Max: orb.Point{p[0] + d, p[1] + d},
+		}.ToRing())
+	}
+}
+
+func (b *builder) lineString(ls orb.LineString) {
+	ls = removeDuplicates(ls)
+	if len(ls) == 0 {
+		return
+	}
+
+	if len(ls) == 1 {
+		b.point(ls[0])
+		return
+	}
+
+	for i := 0; i < len(ls)-1; i++ {
+		b.segment(ls[i], ls[i+1])
+	}
+
+	for i := 1; i < len(ls)-1; i++ {
+		b.join(ls[i-1], ls[i], ls[i+1])
+	}
+
+	if ls[0] == ls[len(ls)-1] {
+		b.join(ls[len(ls)-2], ls[0], ls[1])
+		return
+	}
+
+	b.cap(ls[0], ls[1])
+	b.cap(ls[len(ls)-1], ls[len(ls)-2])
+}
+
+func (b *builder) ring(r orb.Ring) {
+	ls := removeDuplicates(orb.LineString(r))
+	if len(ls) < 3 {
+		return
+	}
+
+	if ls[0] != ls[len(ls)-1] {
+		ls = append(ls, ls[0])
+	}
+
+	for i := 0; i < len(ls)-1; i++ {
+		b.segment(ls[i], ls[i+1])
+	}
+
+	for i := 1; i < len(ls)-1; i++ {
+		b.join(ls[i-1], ls[i], ls[i+1])
+	}
+	b.join(ls[len(ls)-2], ls[0], ls[1])
+}
+
+// segment adds the rectangle covering the points within
+// the distance of the segment.
+func (b *builder) segment(p1, p2 orb.Point) {
+	n := b.normal(p1, p2)
+	b.add(orb.Ring{
+		{p1[0] + n[0], p1[1] + n[1]},
+		{p2[0] + n[0], p2[1] + n[1]},
+		{p2[0] - n[0], p2[1] - n[1]},
+		{p1[0] - n[0], p1[1] - n[1]},
+	})
+}
+
+// cap adds the end cap at p, the line continues towards next.
+func (b *builder) cap(p, next orb.Point) {
+	n := b.normal(next, p)
+	switch b.opts.capStyle {
+	case CapRound:
+		// rotate clockwise from the left side, through the direction of the line.
+		b.add(append(orb.Ring{p}, b.arc(p, n, -math.Pi)...))
+	case CapSquare:
+		dir := orb.Point{-n[1], n[0]}
+		b.add(orb.Ring{
+			{p[0] + n[0], p[1] + n[1]},
+			{p[0] + n[0] - dir[0], p[1] + n[1] - dir[1]},
+			{p[0] - n[0] - dir[0], p[1] - n[1] - dir[1]},
+			{p[0] - n[0], p[1] - n[1]},
+		})
+	}
+}
+
+// join adds the piece on the outside of the corner at p.
+// The inside of the corner is covered by the segment rectangles.
+func (b *builder) join(prev, p, next orb.Point) {
+	turn := (p[0]-prev[0])*(next[1]-p[1]) - (p[1]-prev[1])*(next[0]-p[0])
+	dot := (p[0]-prev[0])*(next[0]-p[0]) + (p[1]-prev[1])*(next[1]-p[1])
+
+	if turn == 0 && dot >= 0 {
+		return // straight
+	}
+
+	u1 := b.normal(prev, p)
+	u2 := b.normal(p, next)
+	if turn > 0 {
+		// left turn, the outside is on the right
+		u1 = orb.Point{-u1[0], -u1[1]}
+		u2 = orb.Point{-u2[0], -u2[1]}
+	}
+
+	q1 := orb.Point{p[0] + u1[0], p[1] + u1[1]}
+	q2 := orb.Point{p[0] + u2[0], p[1] + u2[1]}
+
+	if turn == 0 {
+		// the line doubles back on itself
+		if b.opts.joinStyle == JoinRound {
+			b.add(append(orb.Ring{p}, b.arc(p, u1, -math.Pi)...))
+		}
+		return
+	}
+
+	switch b.opts.joinStyle {
+	case JoinRound:
+		angle := math.Atan2(u1[0]*u2[1]-u1[1]*u2[0], u1[0]*u2[0]+u1[1]*u2[1])
+		b.add(append(orb.Ring{p}, b.arc(p, u1, angle)...))
+	case JoinMiter:
+		d2 := b.distance * b.distance
+		cos := (u1[0]*u2[0] + u1[1]*u2[1]) / d2
+
+		// the ratio of the miter length to the distance is 1/cos(angle/2)
+		if math.Sqrt(2/(1+cos)) <= b.opts.miterLimit {
+			m := orb.Point{
+				p[0] + (u1[0]+u2[0])/(1+cos),
+				p[1] + (u1[1]+u2[1])/(1+cos),
+			}
+			b.add(orb.Ring{p, q1, m, q2})
+			return
+		}
+
+		b.add(orb.Ring{p, q1, q2})
+	case JoinBevel:
+		b.add(orb.Ring{p, q1, q2})
+	}
+}
+
+// normal returns the vector to the left of the segment
+// with the length of the buffer distance.
+func (b *builder) normal(p1, p2 orb.Point) orb.Point {
+	dx := p2[0] - p1[0]
+	dy := p2[1] - p1[1]
+	l := math.Hypot(dx, dy)
+
+	return orb.Point{-dy / l * b.distance, dx / l * b.distance}
+}
+
+// arc returns the points around the center starting at center+u
+// and rotating by the angle. Positive angles are counter-clockwise.
+func (b *builder) arc(center, u orb.Point, angle float64) []orb.Point {
+	step := math.Pi / 2 / float64(b.opts.quadrantSegments)
+	n := int(math.Ceil(math.Abs(angle)/step - 1e-9))
+	if n < 1 {
+		n = 1
+	}
+
+	points := make([]orb.Point, 0, n+1)
+	for i := 0; i <= n; i++ {
+		a := angle * float64(i) / float64(n)
+		sin, cos := math.Sincos(a)
+		points = append(points, orb.Point{
+			center[0] + u[0]*cos - u[1]*sin,
+			center[1] + u[0]*sin + u[1]*cos,
+		})
+	}
+
+	return points
+}
+
+func removeDuplicates(ls orb.LineString) orb.LineString {
+	result := make(orb.LineString, 0, len(ls))
+	for i, p := range ls {
+		if i == 0 || p != ls[i-1] {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
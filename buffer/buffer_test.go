@@ -0,0 +1,233 @@
+package buffer
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+var epsilon = 1e-6
+
+func TestGeometry(t *testing.T) {
+	square := orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	corner := orb.LineString{{0, 0}, {10, 0}, {10, 10}}
+
+	// area of a circle approximated with 32 segments
+	circle := 16 * math.Sin(2*math.Pi/32)
+
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		distance float64
+		opts     []Option
+		area     float64
+		polygons int
+		holes    int
+	}{
+		{
+			name:     "point",
+			geom:     orb.Point{1, 2},
+			distance: 1,
+			area:     circle,
+			polygons: 1,
+		},
+		{
+			name:     "point square cap",
+			geom:     orb.Point{1, 2},
+			distance: 1,
+			opts:     []Option{EndCap(CapSquare)},
+			area:     4,
+			polygons: 1,
+		},
+		{
+			name:     "point flat cap",
+			geom:     orb.Point{1, 2},
+			distance: 1,
+			opts:     []Option{EndCap(CapFlat)},
+			area:     0,
+			polygons: 0,
+		},
+		{
+			name:     "overlapping points",
+			geom:     orb.MultiPoint{{0, 0}, {1, 0}},
+			distance: 1,
+			opts:     []Option{EndCap(CapSquare)},
+			area:     6,
+			polygons: 1,
+		},
+		{
+			name:     "line flat cap",
+			geom:     orb.LineString{{0, 0}, {10, 0}},
+			distance: 1,
+			opts:     []Option{EndCap(CapFlat)},
+			area:     20,
+			polygons: 1,
+		},
+		{
+			name:     "line square cap",
+			geom:     orb.LineString{{0, 0}, {10, 0}},
+			distance: 1,
+			opts:     []Option{EndCap(CapSquare)},
+			area:     24,
+			polygons: 1,
+		},
+		{
+			name:     "line round cap",
+			geom:     orb.LineString{{0, 0}, {10, 0}},
+			distance: 1,
+			area:     20 + circle,
+			polygons: 1,
+		},
+		{
+			name:     "corner miter join",
+			geom:     corner,
+			distance: 1,
+			opts:     []Option{EndCap(CapFlat), Join(JoinMiter)},
+			area:     40,
+			polygons: 1,
+		},
+		{
+			name:     "corner bevel join",
+			geom:     corner,
+			distance: 1,
+			opts:     []Option{EndCap(CapFlat), Join(JoinBevel)},
+			area:     39.5,
+			polygons: 1,
+		},
+		{
+			name:     "corner miter limit",
+			geom:     corner,
+			distance: 1,
+			opts:     []Option{EndCap(CapFlat), Join(JoinMiter), MiterLimit(1.2)},
+			area:     39.5,
+			polygons: 1,
+		},
+		{
+			name:     "corner round join",
+			geom:     corner,
+			distance: 1,
+			opts:     []Option{EndCap(CapFlat)},
+			area:     39 + circle/4,
+			polygons: 1,
+		},
+		{
+			name:     "closed line",
+			geom:     orb.LineString{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			distance: 1,
+			opts:     []Option{Join(JoinMiter)},
+			area:     144 - 64,
+			polygons: 1,
+			holes:    1,
+		},
+		{
+			name:     "polygon",
+			geom:     square,
+			distance: 1,
+			opts:     []Option{Join(JoinMiter)},
+			area:     144,
+			polygons: 1,
+		},
+		{
+			name:     "polygon round join",
+			geom:     square,
+			distance: 1,
+			area:     140 + circle,
+			polygons: 1,
+		},
+		{
+			name:     "polygon zero",
+			geom:     square,
+			distance: 0,
+			area:     100,
+			polygons: 1,
+		},
+		{
+			name:     "polygon negative",
+			geom:     square,
+			distance: -1,
+			area:     64,
+			polygons: 1,
+		},
+		{
+			name:     "polygon negative too much",
+			geom:     square,
+			distance: -6,
+			area:     0,
+			polygons: 0,
+		},
+		{
+			name: "polygon with hole negative",
+			geom: orb.Polygon{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{4, 4}, {4, 6}, {6, 6}, {6, 4}, {4, 4}},
+			},
+			distance: -1,
+			opts:     []Option{Join(JoinMiter)},
+			area:     64 - 16,
+			polygons: 1,
+			holes:    1,
+		},
+		{
+			name:     "negative line",
+			geom:     orb.LineString{{0, 0}, {10, 0}},
+			distance: -1,
+			area:     0,
+			polygons: 0,
+		},
+		{
+			name: "collection",
+			geom: orb.Collection{
+				orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+				orb.LineString{{2, 1}, {4, 1}},
+			},
+			distance: 1,
+			opts:     []Option{Join(JoinMiter), EndCap(CapFlat)},
+			area:     16 + 2,
+			polygons: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := Geometry(tc.geom, tc.distance, tc.opts...)
+
+			if a := planar.Area(mp); math.Abs(a-tc.area) > epsilon {
+				t.Errorf("incorrect area: %v != %v", a, tc.area)
+			}
+
+			if len(mp) != tc.polygons {
+				t.Errorf("incorrect number of polygons: %v != %v", len(mp), tc.polygons)
+			}
+
+			holes := 0
+			for _, p := range mp {
+				holes += len(p) - 1
+			}
+
+			if holes != tc.holes {
+				t.Errorf("incorrect number of holes: %v != %v", holes, tc.holes)
+			}
+		})
+	}
+}
+
+func TestGeometry_quadrantSegments(t *testing.T) {
+	mp := Geometry(orb.Point{0, 0}, 1, QuadrantSegments(1))
+	if l := len(mp[0][0]); l != 5 {
+		t.Errorf("should be a diamond: %v", mp)
+	}
+
+	mp = Geometry(orb.Point{0, 0}, 1, QuadrantSegments(16))
+	if l := len(mp[0][0]); l != 65 {
+		t.Errorf("incorrect number of points: %v", l)
+	}
+}
+
+func TestGeometry_allGeometries(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		Geometry(g, 1)
+		Geometry(g, -1)
+	}
+}
@@ -0,0 +1,30 @@
+package buffer_test
+
+import (
+	"fmt"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/buffer"
+	"github.com/paulmach/orb/planar"
+)
+
+func ExampleGeometry() {
+	parcel := orb.Polygon{{{0, 0}, {30, 0}, {30, 20}, {0, 20}, {0, 0}}}
+	zone := buffer.Geometry(parcel, -5, buffer.Join(buffer.JoinMiter))
+
+	fmt.Println(planar.Area(zone))
+	// Output:
+	// 200
+}
+
+func ExampleGeometry_lineString() {
+	route := orb.LineString{{0, 0}, {100, 0}, {100, 100}}
+	corridor := buffer.Geometry(route, 10,
+		buffer.EndCap(buffer.CapFlat),
+		buffer.Join(buffer.JoinMiter),
+	)
+
+	fmt.Println(planar.Area(corridor))
+	// Output:
+	// 4000
+}
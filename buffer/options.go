@@ -0,0 +1,86 @@
+package buffer
+
+// CapStyle defines how the ends of lines are buffered.
+type CapStyle int
+
+// The possible end cap styles.
+const (
+	// CapRound adds a half circle at the end of the line.
+	CapRound CapStyle = iota
+
+	// CapFlat ends the buffer at the end of the line.
+	CapFlat
+
+	// CapSquare extends the buffer past the end of the line by the distance.
+	CapSquare
+)
+
+// JoinStyle defines how the corners on the outside of a turn are buffered.
+type JoinStyle int
+
+// The possible join styles.
+const (
+	// JoinRound uses a circular arc around the corner.
+	JoinRound JoinStyle = iota
+
+	// JoinMiter extends the offset edges until they meet. If this point
+	// is further than the miter limit a bevel is used instead.
+	JoinMiter
+
+	// JoinBevel connects the offset edges with a straight line.
+	JoinBevel
+)
+
+type options struct {
+	capStyle         CapStyle
+	joinStyle        JoinStyle
+	miterLimit       float64
+	quadrantSegments int
+}
+
+func defaultOptions() *options {
+	return &options{
+		capStyle:         CapRound,
+		joinStyle:        JoinRound,
+		miterLimit:       5,
+		quadrantSegments: 8,
+	}
+}
+
+// An Option is a possible parameter to the buffer operations.
+type Option func(*options)
+
+// EndCap sets the style used for the ends of lines and for points.
+// The default is CapRound.
+func EndCap(s CapStyle) Option {
+	return func(o *options) {
+		o.capStyle = s
+	}
+}
+
+// Join sets the style used for the corners of lines and rings.
+// The default is JoinRound.
+func Join(s JoinStyle) Option {
+	return func(o *options) {
+		o.joinStyle = s
+	}
+}
+
+// MiterLimit sets the ratio of the miter length to the buffer distance
+// after which a miter join is replaced by a bevel. The default is 5.
+func MiterLimit(l float64) Option {
+	return func(o *options) {
+		o.miterLimit = l
+	}
+}
+
+// QuadrantSegments sets the number of segments used to approximate
+// a quarter circle for round caps and joins. The default is 8.
+func QuadrantSegments(n int) Option {
+	return func(o *options) {
+		if n < 1 {
+			n = 1
+		}
+		o.quadrantSegments = n
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"math"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 // Geometry will clip the geometry to the bounding box using the
@@ -101,6 +102,10 @@ func Geometry(b orb.Bound, g orb.Geometry) orb.Geometry {
 		return b
 	}
 
+	if zm.LayoutOf(g) != zm.XY {
+		return Geometry(b, zm.ToXY(g))
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g))
 }
 
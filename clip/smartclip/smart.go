@@ -8,6 +8,7 @@ import (
 
 	"github.com/paulmach/orb"
 	"github.com/paulmach/orb/clip"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 // Geometry will do a smart more involved clipping and wrapping of the geometry.
@@ -47,6 +48,10 @@ func Geometry(box orb.Bound, g orb.Geometry, o orb.Orientation) orb.Geometry {
 
 		return result
 	default:
+		if zm.LayoutOf(g) != zm.XY {
+			return Geometry(box, zm.ToXY(g), o)
+		}
+
 		panic(fmt.Sprintf("geometry type not supported: %T", g))
 	}
 
@@ -52,5 +52,9 @@ func Clone(g Geometry) Geometry {
 		return g
 	}
 
+	if c, ok := cloneZM(g); ok {
+		return c
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g))
 }
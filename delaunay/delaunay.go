@@ -0,0 +1,138 @@
+// Package delaunay computes the Delaunay triangulation and the
+// Voronoi diagram of a set of points in the plane.
+package delaunay
+
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/delaunay"
+)
+
+// Triangulate returns the Delaunay triangles of the points as a collection
+// of polygons. Each polygon is a single counter-clockwise ring of 4 points,
+// the last matching the first. Duplicate points are ignored and collinear
+// points, or less than 3 points, return an empty collection.
+func Triangulate(mp orb.MultiPoint) orb.Collection {
+	t := delaunay.New(mp)
+
+	result := make(orb.Collection, 0, len(t.Triangles)/3)
+	for i := 0; i < len(t.Triangles); i += 3 {
+		a := mp[t.Triangles[i]]
+		b := mp[t.Triangles[i+1]]
+		c := mp[t.Triangles[i+2]]
+
+		result = append(result, orb.Polygon{{a, b, c, a}})
+	}
+
+	return result
+}
+
+// Voronoi returns the Voronoi cell of every point clipped to the bound.
+// The cell is the area closer to that point than any other. The result has
+// a polygon for every point, in the same order, with a single counter-clockwise
+// ring. Cells that are outside the bound, and those of duplicate points after
+// the first, are empty polygons.
+func Voronoi(mp orb.MultiPoint, b orb.Bound) orb.Collection {
+	// the index in unique of the first occurrence of each point
+	first := make(map[orb.Point]int, len(mp))
+	unique := make(orb.MultiPoint, 0, len(mp))
+	for _, p := range mp {
+		if _, ok := first[p]; !ok {
+			first[p] = len(unique)
+			unique = append(unique, p)
+		}
+	}
+
+	neighbors := neighbors(unique)
+	cells := make([]orb.Polygon, len(unique))
+	for i, p := range unique {
+		cell := []orb.Point(b.ToPolygon()[0])
+		cell = cell[:len(cell)-1]
+		for _, j := range neighbors[i] {
+			cell = clipHalfPlane(cell, p, unique[j])
+			if len(cell) == 0 {
+				break
+			}
+		}
+
+		if len(cell) < 3 {
+			cells[i] = orb.Polygon{}
+			continue
+		}
+
+		cells[i] = orb.Polygon{append(orb.Ring(cell), cell[0])}
+	}
+
+	result := make(orb.Collection, 0, len(mp))
+	for _, p := range mp {
+		i := first[p]
+		result = append(result, cells[i])
+
+		// only the first occurrence gets the cell
+		cells[i] = orb.Polygon{}
+	}
+
+	return result
+}
+
+// neighbors returns the indexes of the points that share a Delaunay edge
+// with each point. The Voronoi cells of these points share an edge.
+func neighbors(mp orb.MultiPoint) [][]int {
+	t := delaunay.New(mp)
+	result := make([][]int, len(mp))
+
+	if len(t.Triangles) == 0 {
+		// collinear, the hull is the points sorted along the line
+		for i := 1; i < len(t.Hull); i++ {
+			a, b := t.Hull[i-1], t.Hull[i]
+			result[a] = append(result[a], b)
+			result[b] = append(result[b], a)
+		}
+
+		return result
+	}
+
+	for e, o := range t.Halfedges {
+		if o != -1 && o < e {
+			// interior edges are seen twice
+			continue
+		}
+
+		a, b := t.Triangles[e], t.Triangles[delaunay.Next(e)]
+		result[a] = append(result[a], b)
+		result[b] = append(result[b], a)
+	}
+
+	return result
+}
+
+// clipHalfPlane clips the convex polygon to the half of the plane
+// closer to p than to q.
+func clipHalfPlane(poly []orb.Point, p, q orb.Point) []orb.Point {
+	mid := orb.Point{(p[0] + q[0]) / 2, (p[1] + q[1]) / 2}
+	dx, dy := q[0]-p[0], q[1]-p[1]
+
+	// positive on the q side of the bisector
+	side := func(a orb.Point) float64 {
+		return (a[0]-mid[0])*dx + (a[1]-mid[1])*dy
+	}
+
+	result := make([]orb.Point, 0, len(poly)+1)
+	for i, a := range poly {
+		b := poly[(i+1)%len(poly)]
+		sa, sb := side(a), side(b)
+
+		if sa <= 0 {
+			result = append(result, a)
+		}
+
+		if (sa < 0 && sb > 0) || (sa > 0 && sb < 0) {
+			t := sa / (sa - sb)
+			result = append(result, orb.Point{
+				a[0] + t*(b[0]-a[0]),
+				a[1] + t*(b[1]-a[1]),
+			})
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,180 @@
+package delaunay
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+func TestTriangulate(t *testing.T) {
+	mp := orb.MultiPoint{{0, 0}, {1, 0}, {1, 1}, {0, 1}}
+
+	triangles := Triangulate(mp)
+	if len(triangles) != 2 {
+		t.Fatalf("incorrect number of triangles: %v", len(triangles))
+	}
+
+	area := 0.0
+	for _, g := range triangles {
+		r := g.(orb.Polygon)[0]
+		if len(r) != 4 || r[0] != r[3] {
+			t.Errorf("ring not closed: %v", r)
+		}
+
+		if r.Orientation() != orb.CCW {
+			t.Errorf("ring not counter-clockwise: %v", r)
+		}
+
+		area += planar.Area(g)
+	}
+
+	if math.Abs(area-1) > 1e-10 {
+		t.Errorf("incorrect area: %v", area)
+	}
+}
+
+func TestTriangulate_degenerate(t *testing.T) {
+	cases := []struct {
+		name   string
+		points orb.MultiPoint
+	}{
+		{
+			name:   "empty",
+			points: nil,
+		},
+		{
+			name:   "two points",
+			points: orb.MultiPoint{{0, 0}, {1, 1}},
+		},
+		{
+			name:   "collinear",
+			points: orb.MultiPoint{{0, 0}, {1, 1}, {2, 2}, {3, 3}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if c := Triangulate(tc.points); len(c) != 0 {
+				t.Errorf("should have no triangles: %v", c)
+			}
+		})
+	}
+}
+
+func TestVoronoi(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	bound := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{100, 100}}
+
+	for _, n := range []int{1, 2, 3, 10, 100, 500} {
+		mp := make(orb.MultiPoint, 0, n)
+		for i := 0; i < n; i++ {
+			mp = append(mp, orb.Point{r.Float64() * 100, r.Float64() * 100})
+		}
+
+		cells := Voronoi(mp, bound)
+		if len(cells) != n {
+			t.Fatalf("incorrect number of cells: %v != %v", len(cells), n)
+		}
+
+		area := 0.0
+		for i, c := range cells {
+			p := c.(orb.Polygon)
+			if !planar.PolygonContains(p, mp[i]) {
+				t.Errorf("cell does not contain point %d: %v", i, mp[i])
+			}
+
+			if p[0].Orientation() != orb.CCW {
+				t.Errorf("cell not counter-clockwise: %v", p)
+			}
+
+			area += planar.Area(p)
+		}
+
+		if math.Abs(area-100*100) > 1e-6 {
+			t.Errorf("cells should cover the bound: %v", area)
+		}
+
+		// random locations should be in the cell of the closest point
+		for k := 0; k < 100; k++ {
+			q := orb.Point{r.Float64() * 100, r.Float64() * 100}
+
+			closest := 0
+			for i := range mp {
+				if planar.DistanceSquared(q, mp[i]) < planar.DistanceSquared(q, mp[closest]) {
+					closest = i
+				}
+			}
+
+			if !planar.PolygonContains(cells[closest].(orb.Polygon), q) {
+				t.Errorf("location not in cell of closest point: %v", q)
+			}
+		}
+	}
+}
+
+func TestVoronoi_degenerate(t *testing.T) {
+	bound := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{4, 4}}
+
+	t.Run("collinear", func(t *testing.T) {
+		cells := Voronoi(orb.MultiPoint{{3, 1}, {1, 1}, {2, 1}}, bound)
+
+		expected := []float64{6, 6, 4}
+		for i, c := range cells {
+			if a := planar.Area(c); math.Abs(a-expected[i]) > 1e-10 {
+				t.Errorf("incorrect area for %d: %v", i, a)
+			}
+		}
+	})
+
+	t.Run("duplicate", func(t *testing.T) {
+		cells := Voronoi(orb.MultiPoint{{1, 1}, {3, 3}, {1, 1}}, bound)
+
+		if a := planar.Area(cells[0]); math.Abs(a-8) > 1e-10 {
+			t.Errorf("incorrect area: %v", a)
+		}
+
+		if p := cells[2].(orb.Polygon); len(p) != 0 {
+			t.Errorf("duplicate should be empty: %v", p)
+		}
+	})
+
+	t.Run("all duplicates", func(t *testing.T) {
+		cells := Voronoi(orb.MultiPoint{{1, 1}, {1, 1}}, bound)
+
+		if a := planar.Area(cells[0]); math.Abs(a-16) > 1e-10 {
+			t.Errorf("first should be the whole bound: %v", a)
+		}
+
+		if p := cells[1].(orb.Polygon); len(p) != 0 {
+			t.Errorf("duplicate should be empty: %v", p)
+		}
+	})
+
+	t.Run("duplicate before its neighbors", func(t *testing.T) {
+		cells := Voronoi(orb.MultiPoint{{3, 3}, {3, 3}, {1, 1}, {3, 3}}, bound)
+
+		expected := []float64{8, 0, 8, 0}
+		for i, c := range cells {
+			if a := planar.Area(c); math.Abs(a-expected[i]) > 1e-10 {
+				t.Errorf("incorrect area for %d: %v", i, a)
+			}
+		}
+	})
+
+	t.Run("outside bound", func(t *testing.T) {
+		cells := Voronoi(orb.MultiPoint{{1, 1}, {3, 3}, {10, 10}}, bound)
+
+		if p := cells[2].(orb.Polygon); len(p) != 0 {
+			t.Errorf("cell should be empty: %v", p)
+		}
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		if cells := Voronoi(nil, bound); len(cells) != 0 {
+			t.Errorf("should be empty: %v", cells)
+		}
+	})
+}
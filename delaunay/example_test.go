@@ -0,0 +1,30 @@
+package delaunay_test
+
+import (
+	"fmt"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/delaunay"
+	"github.com/paulmach/orb/planar"
+)
+
+func ExampleTriangulate() {
+	mp := orb.MultiPoint{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {1, 1}}
+
+	triangles := delaunay.Triangulate(mp)
+	fmt.Println(len(triangles))
+	// Output:
+	// 4
+}
+
+func ExampleVoronoi() {
+	depots := orb.MultiPoint{{1, 1}, {3, 1}}
+	bound := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{4, 2}}
+
+	for i, cell := range delaunay.Voronoi(depots, bound) {
+		fmt.Println(i, planar.Area(cell))
+	}
+	// Output:
+	// 0 4
+	// 1 4
+}
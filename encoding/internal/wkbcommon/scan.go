@@ -169,6 +169,24 @@ func ScanPoint(data []byte) (orb.Point, int, error) {
 		return orb.Point{}, 0, err
 	}
 
+	if hasZM(typ) {
+		g, srid, err := unmarshalXY(data)
+		if err != nil {
+			return orb.Point{}, 0, err
+		}
+
+		switch g := g.(type) {
+		case orb.Point:
+			return g, srid, nil
+		case orb.MultiPoint:
+			if len(g) == 1 {
+				return g[0], srid, nil
+			}
+		}
+
+		return orb.Point{}, 0, ErrIncorrectGeometry
+	}
+
 	switch typ {
 	case pointType:
 		p, err := unmarshalPoint(order, geomData)
@@ -192,7 +210,12 @@ func ScanPoint(data []byte) (orb.Point, int, error) {
 
 // ScanMultiPoint takes binary wkb and decodes it into a multi-point.
 func ScanMultiPoint(data []byte) (orb.MultiPoint, int, error) {
-	m, srid, err := Unmarshal(data)
+	unmarshal := Unmarshal
+	if _, typ, _, _, err := unmarshalByteOrderType(data); err == nil && hasZM(typ) {
+		unmarshal = unmarshalXY
+	}
+
+	m, srid, err := unmarshal(data)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -209,21 +232,39 @@ func ScanMultiPoint(data []byte) (orb.MultiPoint, int, error) {
 
 // ScanLineString takes binary wkb and decodes it into a line string.
 func ScanLineString(data []byte) (orb.LineString, int, error) {
-	order, typ, srid, data, err := unmarshalByteOrderType(data)
+	order, typ, srid, geomData, err := unmarshalByteOrderType(data)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	if hasZM(typ) {
+		g, srid, err := unmarshalXY(data)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		switch g := g.(type) {
+		case orb.LineString:
+			return g, srid, nil
+		case orb.MultiLineString:
+			if len(g) == 1 {
+				return g[0], srid, nil
+			}
+		}
+
+		return nil, 0, ErrIncorrectGeometry
+	}
+
 	switch typ {
 	case lineStringType:
-		ls, err := unmarshalLineString(order, data)
+		ls, err := unmarshalLineString(order, geomData)
 		if err != nil {
 			return nil, 0, err
 		}
 
 		return ls, srid, nil
 	case multiLineStringType:
-		mls, err := unmarshalMultiLineString(order, data)
+		mls, err := unmarshalMultiLineString(order, geomData)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -237,21 +278,37 @@ func ScanLineString(data []byte) (orb.LineString, int, error) {
 
 // ScanMultiLineString takes binary wkb and decodes it into a multi-line string.
 func ScanMultiLineString(data []byte) (orb.MultiLineString, int, error) {
-	order, typ, srid, data, err := unmarshalByteOrderType(data)
+	order, typ, srid, geomData, err := unmarshalByteOrderType(data)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	if hasZM(typ) {
+		g, srid, err := unmarshalXY(data)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		switch g := g.(type) {
+		case orb.LineString:
+			return orb.MultiLineString{g}, srid, nil
+		case orb.MultiLineString:
+			return g, srid, nil
+		}
+
+		return nil, 0, ErrIncorrectGeometry
+	}
+
 	switch typ {
 	case lineStringType:
-		ls, err := unmarshalLineString(order, data)
+		ls, err := unmarshalLineString(order, geomData)
 		if err != nil {
 			return nil, 0, err
 		}
 
 		return orb.MultiLineString{ls}, srid, nil
 	case multiLineStringType:
-		ls, err := unmarshalMultiLineString(order, data)
+		ls, err := unmarshalMultiLineString(order, geomData)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -264,21 +321,39 @@ func ScanMultiLineString(data []byte) (orb.MultiLineString, int, error) {
 
 // ScanPolygon takes binary wkb and decodes it into a polygon.
 func ScanPolygon(data []byte) (orb.Polygon, int, error) {
-	order, typ, srid, data, err := unmarshalByteOrderType(data)
+	order, typ, srid, geomData, err := unmarshalByteOrderType(data)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	if hasZM(typ) {
+		g, srid, err := unmarshalXY(data)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		switch g := g.(type) {
+		case orb.Polygon:
+			return g, srid, nil
+		case orb.MultiPolygon:
+			if len(g) == 1 {
+				return g[0], srid, nil
+			}
+		}
+
+		return nil, 0, ErrIncorrectGeometry
+	}
+
 	switch typ {
 	case polygonType:
-		p, err := unmarshalPolygon(order, data)
+		p, err := unmarshalPolygon(order, geomData)
 		if err != nil {
 			return nil, 0, err
 		}
 
 		return p, srid, nil
 	case multiPolygonType:
-		mp, err := unmarshalMultiPolygon(order, data)
+		mp, err := unmarshalMultiPolygon(order, geomData)
 		if err != nil {
 			return nil, 0, err
 		}
@@ -292,20 +367,36 @@ func ScanPolygon(data []byte) (orb.Polygon, int, error) {
 
 // ScanMultiPolygon takes binary wkb and decodes it into a multi-polygon.
 func ScanMultiPolygon(data []byte) (orb.MultiPolygon, int, error) {
-	order, typ, srid, data, err := unmarshalByteOrderType(data)
+	order, typ, srid, geomData, err := unmarshalByteOrderType(data)
 	if err != nil {
 		return nil, 0, err
 	}
 
+	if hasZM(typ) {
+		g, srid, err := unmarshalXY(data)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		switch g := g.(type) {
+		case orb.Polygon:
+			return orb.MultiPolygon{g}, srid, nil
+		case orb.MultiPolygon:
+			return g, srid, nil
+		}
+
+		return nil, 0, ErrIncorrectGeometry
+	}
+
 	switch typ {
 	case polygonType:
-		p, err := unmarshalPolygon(order, data)
+		p, err := unmarshalPolygon(order, geomData)
 		if err != nil {
 			return nil, 0, err
 		}
 		return orb.MultiPolygon{p}, srid, nil
 	case multiPolygonType:
-		mp, err := unmarshalMultiPolygon(order, data)
+		mp, err := unmarshalMultiPolygon(order, geomData)
 		if err != nil {
 			return nil, 0, err
 		}
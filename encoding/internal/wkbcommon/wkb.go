@@ -6,6 +6,7 @@ import (
 	"io"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 // byteOrder represents little or big endian encoding.
@@ -170,7 +171,7 @@ func (e *Encoder) Encode(geom orb.Geometry, srid int) error {
 		return e.writeCollection(g, srid)
 	}
 
-	panic("unsupported type")
+	return e.writeZM(geom, srid, srid != 0)
 }
 
 func (e *Encoder) writeTypePrefix(t uint32, l int, srid int) error {
@@ -225,7 +226,17 @@ func Unmarshal(data []byte) (orb.Geometry, int, error) {
 
 		return g, srid, err
 	default:
-		return nil, 0, ErrUnsupportedGeometry
+		if !hasZM(typ) {
+			return nil, 0, ErrUnsupportedGeometry
+		}
+
+		d := &zmDecoder{r: bytes.NewReader(data), buf: make([]byte, 8)}
+		g, _, err := d.decode()
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			return nil, 0, ErrNotWKB
+		}
+
+		return g, srid, err
 	}
 
 	if err != nil {
@@ -267,7 +278,12 @@ func (d *Decoder) Decode() (orb.Geometry, int, error) {
 	case geometryCollectionType:
 		g, err = readCollection(d.r, order, buf)
 	default:
-		return nil, 0, ErrUnsupportedGeometry
+		if !hasZM(typ) {
+			return nil, 0, ErrUnsupportedGeometry
+		}
+
+		d := &zmDecoder{r: d.r, buf: buf}
+		g, err = d.geometry(order, typ)
 	}
 
 	if err != nil {
@@ -299,7 +315,7 @@ func readByteOrderType(r io.Reader, buf []byte) (byteOrder, uint32, int, error)
 	}
 
 	if typ&ewkbType == 0 {
-		return order, typ, 0, nil
+		return order, normalizeType(typ), 0, nil
 	}
 
 	srid, err := readUint32(r, order, buf[:4])
@@ -307,7 +323,7 @@ func readByteOrderType(r io.Reader, buf []byte) (byteOrder, uint32, int, error)
 		return 0, 0, 0, err
 	}
 
-	return order, typ & 0x0ff, int(srid), nil
+	return order, normalizeType(typ), int(srid), nil
 }
 
 func readUint32(r io.Reader, order byteOrder, buf []byte) (uint32, error) {
@@ -325,7 +341,7 @@ func unmarshalByteOrderType(buf []byte) (byteOrder, uint32, int, []byte, error)
 
 	if typ&ewkbType == 0 {
 		// regular wkb, no srid
-		return order, typ & 0x0F, 0, buf[5:], nil
+		return order, normalizeType(typ), 0, buf[5:], nil
 	}
 
 	if len(buf) < 10 {
@@ -333,7 +349,7 @@ func unmarshalByteOrderType(buf []byte) (byteOrder, uint32, int, []byte, error)
 	}
 
 	srid := unmarshalUint32(order, buf[5:])
-	return order, typ & 0x0F, int(srid), buf[9:], nil
+	return order, normalizeType(typ), int(srid), buf[9:], nil
 }
 
 func byteOrderType(buf []byte) (byteOrder, uint32, error) {
@@ -407,5 +423,9 @@ func GeomLength(geom orb.Geometry, ewkb bool) int {
 		return 9 + sum + ewkbExtra
 	}
 
+	if l := zm.LayoutOf(geom); l != zm.XY {
+		return zmLength(geom, l) + ewkbExtra
+	}
+
 	return 0
 }
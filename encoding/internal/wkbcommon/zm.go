@@ -0,0 +1,488 @@
+package wkbcommon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+const (
+	ewkbZType uint32 = 0x80000000
+	ewkbMType uint32 = 0x40000000
+)
+
+// normalizeType removes the srid flag and converts the ewkb z and m flags
+// to the iso type codes, e.g. a point with z values becomes 1001.
+func normalizeType(typ uint32) uint32 {
+	l := (typ & 0x0fffffff) / 1000
+	if typ&ewkbZType != 0 {
+		l |= uint32(zm.XYZ)
+	}
+
+	if typ&ewkbMType != 0 {
+		l |= uint32(zm.XYM)
+	}
+
+	return (typ&0x0fffffff)%1000 + 1000*l
+}
+
+// splitType returns the base type and the layout of a normalized type.
+func splitType(typ uint32) (uint32, zm.Layout) {
+	return typ % 1000, zm.Layout(typ / 1000)
+}
+
+// hasZM returns true if the normalized type has z and/or m values.
+func hasZM(typ uint32) bool {
+	return typ >= 1000 && typ < 4000
+}
+
+// unmarshalXY decodes the data dropping any z and m values.
+// It is used when scanning into the 2d types.
+func unmarshalXY(data []byte) (orb.Geometry, int, error) {
+	d := &zmDecoder{
+		r:    bytes.NewReader(data),
+		buf:  make([]byte, 8),
+		drop: true,
+	}
+
+	g, srid, err := d.decode()
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, 0, ErrNotWKB
+	}
+
+	return g, srid, err
+}
+
+// zmDecoder reads geometries of any layout off of the stream.
+// If drop is true the z and m values are dropped and the 2d types are returned.
+type zmDecoder struct {
+	r    io.Reader
+	buf  []byte
+	drop bool
+}
+
+func (d *zmDecoder) decode() (orb.Geometry, int, error) {
+	order, typ, srid, err := readByteOrderType(d.r, d.buf)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	g, err := d.geometry(order, typ)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return g, srid, nil
+}
+
+// geometry reads the geometry after the header.
+func (d *zmDecoder) geometry(order byteOrder, typ uint32) (orb.Geometry, error) {
+	base, l := splitType(typ)
+	if l > zm.XYZM {
+		return nil, ErrUnsupportedGeometry
+	}
+
+	out := l
+	if d.drop {
+		out = zm.XY
+	}
+
+	switch base {
+	case pointType:
+		c, err := d.coord(order, l)
+		if err != nil {
+			return nil, err
+		}
+
+		return zm.Point(c, out), nil
+	case lineStringType:
+		cs, err := d.coords(order, l)
+		if err != nil {
+			return nil, err
+		}
+
+		return zm.LineString(cs, out), nil
+	case polygonType:
+		rings, err := d.lines(order, l)
+		if err != nil {
+			return nil, err
+		}
+
+		return zm.Polygon(rings, out), nil
+	case multiPointType:
+		var points []zm.Coord
+		err := d.parts(order, pointType, func(order byteOrder, l zm.Layout) error {
+			c, err := d.coord(order, l)
+			points = append(points, c)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return zm.MultiPoint(points, out), nil
+	case multiLineStringType:
+		var lines [][]zm.Coord
+		err := d.parts(order, lineStringType, func(order byteOrder, l zm.Layout) error {
+			cs, err := d.coords(order, l)
+			lines = append(lines, cs)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return zm.MultiLineString(lines, out), nil
+	case multiPolygonType:
+		var polygons [][][]zm.Coord
+		err := d.parts(order, polygonType, func(order byteOrder, l zm.Layout) error {
+			rings, err := d.lines(order, l)
+			polygons = append(polygons, rings)
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return zm.MultiPolygon(polygons, out), nil
+	case geometryCollectionType:
+		num, err := readUint32(d.r, order, d.buf[:4])
+		if err != nil {
+			return nil, err
+		}
+
+		alloc := num
+		if alloc > MaxMultiAlloc {
+			alloc = MaxMultiAlloc
+		}
+		result := make(orb.Collection, 0, alloc)
+
+		for i := 0; i < int(num); i++ {
+			g, _, err := d.decode()
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, g)
+		}
+
+		return result, nil
+	}
+
+	return nil, ErrUnsupportedGeometry
+}
+
+// parts reads the count and the headers of the parts of a multi geometry.
+// The parts must be of the given type and the read function is called
+// with the byte order and layout of each part.
+func (d *zmDecoder) parts(order byteOrder, expected uint32, read func(byteOrder, zm.Layout) error) error {
+	num, err := readUint32(d.r, order, d.buf[:4])
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < int(num); i++ {
+		order, typ, _, err := readByteOrderType(d.r, d.buf)
+		if err != nil {
+			return err
+		}
+
+		base, l := splitType(typ)
+		if base != expected || l > zm.XYZM {
+			return ErrNotWKB
+		}
+
+		if err := read(order, l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *zmDecoder) coord(order byteOrder, l zm.Layout) (zm.Coord, error) {
+	var c zm.Coord
+	for i := 0; i < l.Size(); i++ {
+		if _, err := io.ReadFull(d.r, d.buf[:8]); err != nil {
+			return c, err
+		}
+
+		if order == littleEndian {
+			c[i] = math.Float64frombits(binary.LittleEndian.Uint64(d.buf))
+		} else {
+			c[i] = math.Float64frombits(binary.BigEndian.Uint64(d.buf))
+		}
+	}
+
+	return c, nil
+}
+
+func (d *zmDecoder) coords(order byteOrder, l zm.Layout) ([]zm.Coord, error) {
+	num, err := readUint32(d.r, order, d.buf[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	alloc := num
+	if alloc > MaxPointsAlloc {
+		// invalid data can come in here and allocate tons of memory.
+		alloc = MaxPointsAlloc
+	}
+	result := make([]zm.Coord, 0, alloc)
+
+	for i := 0; i < int(num); i++ {
+		c, err := d.coord(order, l)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, c)
+	}
+
+	return result, nil
+}
+
+func (d *zmDecoder) lines(order byteOrder, l zm.Layout) ([][]zm.Coord, error) {
+	num, err := readUint32(d.r, order, d.buf[:4])
+	if err != nil {
+		return nil, err
+	}
+
+	alloc := num
+	if alloc > MaxMultiAlloc {
+		alloc = MaxMultiAlloc
+	}
+	result := make([][]zm.Coord, 0, alloc)
+
+	for i := 0; i < int(num); i++ {
+		cs, err := d.coords(order, l)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, cs)
+	}
+
+	return result, nil
+}
+
+// writeZM writes the geometries with z and/or m values. If flags is true
+// the ewkb flags are used for the type, otherwise the iso type codes.
+// The byte order has already been written.
+func (e *Encoder) writeZM(geom orb.Geometry, srid int, flags bool) error {
+	l := zm.LayoutOf(geom)
+
+	var base uint32
+	var parts []orb.Geometry
+	switch g := geom.(type) {
+	case orb.PointZ, orb.PointM, orb.PointZM:
+		if err := e.writeZMType(pointType, l, srid, flags); err != nil {
+			return err
+		}
+
+		return e.writeCoords(zm.Points(g)[0], l)
+	case orb.LineStringZ, orb.LineStringM, orb.LineStringZM:
+		if err := e.writeZMType(lineStringType, l, srid, flags); err != nil {
+			return err
+		}
+
+		return e.writeLine(zm.Points(g), l)
+	case orb.PolygonZ, orb.PolygonM, orb.PolygonZM:
+		if err := e.writeZMType(polygonType, l, srid, flags); err != nil {
+			return err
+		}
+
+		rings := zm.Lines(g)
+		if err := e.writeCount(len(rings)); err != nil {
+			return err
+		}
+
+		for _, r := range rings {
+			if err := e.writeLine(r, l); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case orb.MultiPointZ:
+		base = multiPointType
+		for _, p := range g {
+			parts = append(parts, p)
+		}
+	case orb.MultiPointM:
+		base = multiPointType
+		for _, p := range g {
+			parts = append(parts, p)
+		}
+	case orb.MultiPointZM:
+		base = multiPointType
+		for _, p := range g {
+			parts = append(parts, p)
+		}
+	case orb.MultiLineStringZ:
+		base = multiLineStringType
+		for _, ls := range g {
+			parts = append(parts, ls)
+		}
+	case orb.MultiLineStringM:
+		base = multiLineStringType
+		for _, ls := range g {
+			parts = append(parts, ls)
+		}
+	case orb.MultiLineStringZM:
+		base = multiLineStringType
+		for _, ls := range g {
+			parts = append(parts, ls)
+		}
+	case orb.MultiPolygonZ:
+		base = multiPolygonType
+		for _, p := range g {
+			parts = append(parts, p)
+		}
+	case orb.MultiPolygonM:
+		base = multiPolygonType
+		for _, p := range g {
+			parts = append(parts, p)
+		}
+	case orb.MultiPolygonZM:
+		base = multiPolygonType
+		for _, p := range g {
+			parts = append(parts, p)
+		}
+	default:
+		panic("unsupported type")
+	}
+
+	if err := e.writeZMType(base, l, srid, flags); err != nil {
+		return err
+	}
+
+	if err := e.writeCount(len(parts)); err != nil {
+		return err
+	}
+
+	for _, p := range parts {
+		if err := e.writeByteOrder(); err != nil {
+			return err
+		}
+
+		if err := e.writeZM(p, 0, flags); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Encoder) writeZMType(base uint32, l zm.Layout, srid int, flags bool) error {
+	t := base + 1000*uint32(l)
+	if flags {
+		t = base
+		if l.HasZ() {
+			t |= ewkbZType
+		}
+
+		if l.HasM() {
+			t |= ewkbMType
+		}
+	}
+
+	if srid != 0 {
+		t |= ewkbType
+	}
+
+	e.order.PutUint32(e.buf, t)
+	if srid == 0 {
+		_, err := e.w.Write(e.buf[:4])
+		return err
+	}
+
+	e.order.PutUint32(e.buf[4:], uint32(srid))
+	_, err := e.w.Write(e.buf[:8])
+	return err
+}
+
+func (e *Encoder) writeByteOrder() error {
+	if e.order == binary.LittleEndian {
+		e.buf[0] = 1
+	} else {
+		e.buf[0] = 0
+	}
+
+	_, err := e.w.Write(e.buf[:1])
+	return err
+}
+
+func (e *Encoder) writeCount(n int) error {
+	e.order.PutUint32(e.buf, uint32(n))
+	_, err := e.w.Write(e.buf[:4])
+	return err
+}
+
+func (e *Encoder) writeCoords(c zm.Coord, l zm.Layout) error {
+	for i := 0; i < l.Size(); i++ {
+		e.order.PutUint64(e.buf, math.Float64bits(c[i]))
+		if _, err := e.w.Write(e.buf[:8]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (e *Encoder) writeLine(cs []zm.Coord, l zm.Layout) error {
+	if err := e.writeCount(len(cs)); err != nil {
+		return err
+	}
+
+	for _, c := range cs {
+		if err := e.writeCoords(c, l); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// zmLength returns the encoded length of a geometry with z and/or m values.
+func zmLength(geom orb.Geometry, l zm.Layout) int {
+	size := 8 * l.Size()
+
+	if points := zm.Points(geom); points != nil {
+		switch geom.GeoJSONType() {
+		case "Point":
+			return 5 + size
+		case "MultiPoint":
+			return 9 + (5+size)*len(points)
+		}
+
+		return 9 + size*len(points)
+	}
+
+	if lines := zm.Lines(geom); lines != nil {
+		sum := 0
+		for _, cs := range lines {
+			sum += 4 + size*len(cs)
+		}
+
+		if geom.GeoJSONType() == "Polygon" {
+			return 9 + sum
+		}
+
+		return 9 + sum + 5*len(lines)
+	}
+
+	sum := 0
+	for _, rings := range zm.Polygons(geom) {
+		sum += 9
+		for _, cs := range rings {
+			sum += 4 + size*len(cs)
+		}
+	}
+
+	return 9 + sum
+}
@@ -0,0 +1,179 @@
+package wkbcommon
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+var zmGeometries = []orb.Geometry{
+	orb.PointZ{1, 2, 3},
+	orb.PointM{1, 2, 4},
+	orb.PointZM{1, 2, 3, 4},
+	orb.MultiPointZ{{1, 2, 3}, {4, 5, 6}},
+	orb.MultiPointM{{1, 2, 3}, {4, 5, 6}},
+	orb.MultiPointZM{{1, 2, 3, 4}, {5, 6, 7, 8}},
+	orb.LineStringZ{{1, 2, 3}, {4, 5, 6}},
+	orb.LineStringM{{1, 2, 3}, {4, 5, 6}},
+	orb.LineStringZM{{1, 2, 3, 4}, {5, 6, 7, 8}},
+	orb.MultiLineStringZ{{{1, 2, 3}, {4, 5, 6}}, {{7, 8, 9}}},
+	orb.MultiLineStringM{{{1, 2, 3}, {4, 5, 6}}, {{7, 8, 9}}},
+	orb.MultiLineStringZM{{{1, 2, 3, 4}, {5, 6, 7, 8}}, {}},
+	orb.PolygonZ{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}},
+	orb.PolygonM{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}},
+	orb.PolygonZM{{{0, 0, 1, 2}, {1, 0, 2, 3}, {1, 1, 3, 4}, {0, 0, 1, 2}}},
+	orb.MultiPolygonZ{{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}}, {}},
+	orb.MultiPolygonM{{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}}},
+	orb.MultiPolygonZM{{{{0, 0, 1, 2}, {1, 0, 2, 3}, {1, 1, 3, 4}, {0, 0, 1, 2}}}},
+	orb.Collection{orb.PointZ{1, 2, 3}, orb.Point{4, 5}, orb.LineStringM{{1, 2, 3}}},
+}
+
+func TestZM(t *testing.T) {
+	for _, g := range zmGeometries {
+		for _, srid := range []int{0, 4326} {
+			for _, order := range []binary.ByteOrder{binary.LittleEndian, binary.BigEndian} {
+				data, err := Marshal(g, srid, order)
+				if err != nil {
+					t.Fatalf("marshal error: %v", err)
+				}
+
+				if _, ok := g.(orb.Collection); ok {
+					g2, s, err := Unmarshal(data)
+					if err != nil {
+						t.Fatalf("unmarshal error: %v", err)
+					}
+
+					if s != srid || !orb.Equal(g, g2) {
+						t.Errorf("incorrect collection: %v %v", s, g2)
+					}
+					continue
+				}
+
+				compare(t, g, srid, data)
+			}
+		}
+	}
+}
+
+func TestZM_typeCodes(t *testing.T) {
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		srid     int
+		expected string
+	}{
+		{
+			name:     "iso point z",
+			geom:     orb.PointZ{1, 2, 3},
+			expected: "01e9030000000000000000f03f00000000000000400000000000000840",
+		},
+		{
+			name:     "iso point m",
+			geom:     orb.PointM{1, 2, 3},
+			expected: "01d1070000000000000000f03f00000000000000400000000000000840",
+		},
+		{
+			name:     "iso point zm",
+			geom:     orb.PointZM{1, 2, 3, 4},
+			expected: "01b90b0000000000000000f03f000000000000004000000000000008400000000000001040",
+		},
+		{
+			name:     "ewkb point z",
+			geom:     orb.PointZ{1, 2, 3},
+			srid:     4326,
+			expected: "01010000a0e6100000000000000000f03f00000000000000400000000000000840",
+		},
+		{
+			name:     "ewkb point m",
+			geom:     orb.PointM{1, 2, 3},
+			srid:     4326,
+			expected: "0101000060e6100000000000000000f03f00000000000000400000000000000840",
+		},
+		{
+			name:     "ewkb point zm",
+			geom:     orb.PointZM{1, 2, 3, 4},
+			srid:     4326,
+			expected: "01010000e0e6100000000000000000f03f000000000000004000000000000008400000000000001040",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data := MustMarshal(tc.geom, tc.srid, binary.LittleEndian)
+			if h := hex.EncodeToString(data); h != tc.expected {
+				t.Errorf("incorrect encoding: %v", h)
+			}
+
+			compare(t, tc.geom, tc.srid, data)
+		})
+	}
+}
+
+func TestZM_ewkbWithoutSRID(t *testing.T) {
+	// flags without the srid, as written by some libraries
+	data, _ := hex.DecodeString("0101000080000000000000f03f00000000000000400000000000000840")
+
+	g, _, err := Unmarshal(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !orb.Equal(g, orb.PointZ{1, 2, 3}) {
+		t.Errorf("incorrect geometry: %v", g)
+	}
+
+	g, _, err = NewDecoder(bytes.NewReader(data)).Decode()
+	if err != nil {
+		t.Fatalf("decode error: %v", err)
+	}
+
+	if !orb.Equal(g, orb.PointZ{1, 2, 3}) {
+		t.Errorf("incorrect geometry: %v", g)
+	}
+}
+
+func TestZM_scan(t *testing.T) {
+	p, _, err := ScanPoint(MustMarshal(orb.PointZM{1, 2, 3, 4}, 0))
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+
+	if !p.Equal(orb.Point{1, 2}) {
+		t.Errorf("incorrect point: %v", p)
+	}
+
+	mp, _, err := ScanMultiPoint(MustMarshal(orb.PointZ{1, 2, 3}, 4326))
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+
+	if !mp.Equal(orb.MultiPoint{{1, 2}}) {
+		t.Errorf("incorrect multi point: %v", mp)
+	}
+
+	ls, _, err := ScanLineString(MustMarshal(orb.MultiLineStringM{{{1, 2, 3}, {4, 5, 6}}}, 0))
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+
+	if !ls.Equal(orb.LineString{{1, 2}, {4, 5}}) {
+		t.Errorf("incorrect line string: %v", ls)
+	}
+
+	poly, _, err := ScanPolygon(MustMarshal(zmGeometries[12], 0))
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+
+	if !poly.Equal(orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}) {
+		t.Errorf("incorrect polygon: %v", poly)
+	}
+
+	_, _, err = ScanPolygon(MustMarshal(orb.PointZ{1, 2, 3}, 0))
+	if err != ErrIncorrectGeometry {
+		t.Errorf("incorrect error: %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/paulmach/orb"
 	"github.com/paulmach/orb/encoding/mvt/vectortile"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 const (
@@ -87,6 +88,10 @@ func encodeGeometry(g orb.Geometry) (vectortile.Tile_GeomType, []uint32, error)
 		return encodeGeometry(g.ToPolygon())
 	}
 
+	if zm.LayoutOf(g) != zm.XY {
+		return encodeGeometry(zm.ToXY(g))
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g))
 }
 
@@ -607,3 +607,32 @@ func BenchmarkProjectToGeo(b *testing.B) {
 		layers.ProjectToWGS84(tile)
 	}
 }
+
+func TestMarshal_zm(t *testing.T) {
+	data := []byte(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","geometry":{"type":"LineString","coordinates":[[-122.4,37.8,10],[-122.3,37.7,20]]},"properties":{}}
+	]}`)
+
+	fc, err := geojson.UnmarshalFeatureCollection(data, geojson.WithZM())
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	tile := maptile.New(1309, 3166, 13)
+	layers := NewLayers(map[string]*geojson.FeatureCollection{"roads": fc})
+	layers.ProjectToTile(tile)
+
+	data, err = Marshal(layers)
+	if err != nil {
+		t.Fatalf("marshal error: %v", err)
+	}
+
+	layers, err = Unmarshal(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if _, ok := layers[0].Features[0].Geometry.(orb.LineString); !ok {
+		t.Errorf("should be a 2d line string: %T", layers[0].Features[0].Geometry)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 var (
@@ -22,12 +23,13 @@ var (
 
 // UnmarshalPoint returns the point represented by the wkt string.
 // Will return ErrIncorrectGeometry if the wkt is not a point.
+// Any z or m values are dropped.
 func UnmarshalPoint(s string) (p orb.Point, err error) {
 	geom, err := Unmarshal(s)
 	if err != nil {
 		return orb.Point{}, err
 	}
-	g, ok := geom.(orb.Point)
+	g, ok := zm.ToXY(geom).(orb.Point)
 	if !ok {
 		return orb.Point{}, ErrIncorrectGeometry
 	}
@@ -36,13 +38,14 @@ func UnmarshalPoint(s string) (p orb.Point, err error) {
 
 // UnmarshalMultiPoint returns the multi-point represented by the wkt string.
 // Will return ErrIncorrectGeometry if the wkt is not a multi-point.
+// Any z or m values are dropped.
 func UnmarshalMultiPoint(s string) (p orb.MultiPoint, err error) {
 	geom, err := Unmarshal(s)
 	if err != nil {
 		return nil, err
 	}
 
-	g, ok := geom.(orb.MultiPoint)
+	g, ok := zm.ToXY(geom).(orb.MultiPoint)
 	if !ok {
 		return nil, ErrIncorrectGeometry
 	}
@@ -51,12 +54,13 @@ func UnmarshalMultiPoint(s string) (p orb.MultiPoint, err error) {
 
 // UnmarshalLineString returns the linestring represented by the wkt string.
 // Will return ErrIncorrectGeometry if the wkt is not a linestring.
+// Any z or m values are dropped.
 func UnmarshalLineString(s string) (p orb.LineString, err error) {
 	geom, err := Unmarshal(s)
 	if err != nil {
 		return nil, err
 	}
-	g, ok := geom.(orb.LineString)
+	g, ok := zm.ToXY(geom).(orb.LineString)
 	if !ok {
 		return nil, ErrIncorrectGeometry
 	}
@@ -65,12 +69,13 @@ func UnmarshalLineString(s string) (p orb.LineString, err error) {
 
 // UnmarshalMultiLineString returns the multi-linestring represented by the wkt string.
 // Will return ErrIncorrectGeometry if the wkt is not a multi-linestring.
+// Any z or m values are dropped.
 func UnmarshalMultiLineString(s string) (p orb.MultiLineString, err error) {
 	geom, err := Unmarshal(s)
 	if err != nil {
 		return nil, err
 	}
-	g, ok := geom.(orb.MultiLineString)
+	g, ok := zm.ToXY(geom).(orb.MultiLineString)
 	if !ok {
 		return nil, ErrIncorrectGeometry
 	}
@@ -79,12 +84,13 @@ func UnmarshalMultiLineString(s string) (p orb.MultiLineString, err error) {
 
 // UnmarshalPolygon returns the polygon represented by the wkt string.
 // Will return ErrIncorrectGeometry if the wkt is not a polygon.
+// Any z or m values are dropped.
 func UnmarshalPolygon(s string) (p orb.Polygon, err error) {
 	geom, err := Unmarshal(s)
 	if err != nil {
 		return nil, err
 	}
-	g, ok := geom.(orb.Polygon)
+	g, ok := zm.ToXY(geom).(orb.Polygon)
 	if !ok {
 		return nil, ErrIncorrectGeometry
 	}
@@ -93,12 +99,13 @@ func UnmarshalPolygon(s string) (p orb.Polygon, err error) {
 
 // UnmarshalMultiPolygon returns the multi-polygon represented by the wkt string.
 // Will return ErrIncorrectGeometry if the wkt is not a multi-polygon.
+// Any z or m values are dropped.
 func UnmarshalMultiPolygon(s string) (p orb.MultiPolygon, err error) {
 	geom, err := Unmarshal(s)
 	if err != nil {
 		return nil, err
 	}
-	g, ok := geom.(orb.MultiPolygon)
+	g, ok := zm.ToXY(geom).(orb.MultiPolygon)
 	if !ok {
 		return nil, ErrIncorrectGeometry
 	}
@@ -132,70 +139,137 @@ func trimSpaceBrackets(s string) string {
 	return strings.Trim(s, " ")
 }
 
-// parsePoint pase point by (x y)
-func parsePoint(s string) (p orb.Point, err error) {
-	ps := strings.Split(s, " ")
-	if len(ps) != 2 {
-		return orb.Point{}, ErrNotWKT
+// coordParser parses the coordinates of a geometry making sure they
+// all have the same number of values. If the geometry was not tagged
+// with Z, M or ZM the layout is defined by the first coordinate:
+// 3 values are x, y, z and 4 values are x, y, z, m.
+type coordParser struct {
+	layout zm.Layout
+	known  bool
+}
+
+// parseLayout removes the Z, M or ZM tag from the start of the string.
+func parseLayout(s string) (string, *coordParser) {
+	s = strings.TrimLeft(s, " ")
+	for _, t := range []struct {
+		tag    string
+		layout zm.Layout
+	}{
+		{"ZM", zm.XYZM},
+		{"Z", zm.XYZ},
+		{"M", zm.XYM},
+	} {
+		if strings.HasPrefix(s, t.tag) {
+			return strings.TrimLeft(s[len(t.tag):], " "), &coordParser{layout: t.layout, known: true}
+		}
 	}
 
-	x, err := strconv.ParseFloat(ps[0], 64)
-	if err != nil {
-		return orb.Point{}, err
+	return s, &coordParser{}
+}
+
+// point parses a point given by (x y) or one of (x y z), (x y m) and (x y z m).
+func (cp *coordParser) point(s string) (zm.Coord, error) {
+	var c zm.Coord
+
+	vs := strings.Fields(trimSpaceBrackets(s))
+	if len(vs) < 2 || len(vs) > 4 {
+		return c, ErrNotWKT
 	}
 
-	y, err := strconv.ParseFloat(ps[1], 64)
-	if err != nil {
-		return orb.Point{}, err
+	if !cp.known {
+		cp.known = true
+		cp.layout = zm.Layout(len(vs) - 2)
+		if len(vs) == 4 {
+			cp.layout = zm.XYZM
+		}
+	}
+
+	if len(vs) != cp.layout.Size() {
+		return c, ErrNotWKT
+	}
+
+	for i, v := range vs {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			return c, err
+		}
+		c[i] = f
 	}
 
-	return orb.Point{x, y}, nil
+	return c, nil
 }
 
-// splitGeometryCollection split GEOMETRYCOLLECTION to more geometry
-func splitGeometryCollection(s string) (r []string) {
-	r = make([]string, 0)
-	stack := make([]rune, 0)
-	l := len(s)
-	for i, v := range s {
-		if !strings.Contains(string(stack), "(") {
-			stack = append(stack, v)
-			continue
+// points parses a comma separated list of points.
+func (cp *coordParser) points(s string) ([]zm.Coord, error) {
+	ps := strings.Split(trimSpaceBrackets(s), ",")
+	result := make([]zm.Coord, 0, len(ps))
+	for _, p := range ps {
+		c, err := cp.point(p)
+		if err != nil {
+			return nil, err
 		}
-		if v >= 'A' && v < 'Z' {
-			t := string(stack)
-			r = append(r, t[:len(t)-1])
-			stack = make([]rune, 0)
-			stack = append(stack, v)
-			continue
+		result = append(result, c)
+	}
+
+	return result, nil
+}
+
+// lines parses a comma separated list of point lists, e.g. the rings of a polygon.
+func (cp *coordParser) lines(s string) ([][]zm.Coord, error) {
+	ls := splitTop(trimSpaceBrackets(s))
+	result := make([][]zm.Coord, 0, len(ls))
+	for _, l := range ls {
+		cs, err := cp.points(l)
+		if err != nil {
+			return nil, err
 		}
-		if i == l-1 {
-			r = append(r, string(stack))
-			continue
+		result = append(result, cs)
+	}
+
+	return result, nil
+}
+
+// splitTop splits the string at the commas that are not inside brackets.
+func splitTop(s string) []string {
+	var result []string
+
+	depth, start := 0, 0
+	for i, r := range s {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				result = append(result, s[start:i])
+				start = i + 1
+			}
 		}
-		stack = append(stack, v)
 	}
-	return
+
+	return append(result, s[start:])
 }
 
 // Unmarshal return a geometry by parsing the WKT string.
+// Geometries tagged with Z, M or ZM, or with 3 or 4 values per point,
+// return the orb types with z and/or m values, e.g. orb.PointZ.
 func Unmarshal(s string) (geom orb.Geometry, err error) {
 	s = strings.ToUpper(strings.Trim(s, " "))
 	switch {
 	case strings.Contains(s, "GEOMETRYCOLLECTION"):
-		if s == "GEOMETRYCOLLECTION EMPTY" {
+		// the members have their own tags
+		s, _ = parseLayout(strings.Replace(s, "GEOMETRYCOLLECTION", "", 1))
+		if s == "EMPTY" {
 			return orb.Collection{}, nil
 		}
-		s = strings.Replace(s, "GEOMETRYCOLLECTION", "", -1)
+
 		c := orb.Collection{}
-		ms := splitGeometryCollection(s)
-		if len(ms) == 0 {
-			return nil, err
-		}
-		for _, v := range ms {
-			if len(v) == 0 {
+		for _, v := range splitTop(trimSpaceBrackets(s)) {
+			if len(strings.Trim(v, " ")) == 0 {
 				continue
 			}
+
 			g, err := Unmarshal(v)
 			if err != nil {
 				return nil, err
@@ -205,111 +279,77 @@ func Unmarshal(s string) (geom orb.Geometry, err error) {
 		geom = c
 
 	case strings.Contains(s, "MULTIPOINT"):
-		if s == "MULTIPOINT EMPTY" {
-			return orb.MultiPoint{}, nil
+		s, cp := parseLayout(strings.Replace(s, "MULTIPOINT", "", -1))
+		if s == "EMPTY" {
+			return zm.MultiPoint(nil, cp.layout), nil
 		}
-		s = strings.Replace(s, "MULTIPOINT", "", -1)
-		s = trimSpaceBrackets(s)
-		ps := strings.Split(s, ",")
-		mp := orb.MultiPoint{}
-		for _, p := range ps {
-			tp, err := parsePoint(trimSpaceBrackets(p))
-			if err != nil {
-				return nil, err
-			}
-			mp = append(mp, tp)
+
+		cs, err := cp.points(s)
+		if err != nil {
+			return nil, err
 		}
-		geom = mp
+		geom = zm.MultiPoint(cs, cp.layout)
 
 	case strings.Contains(s, "POINT"):
-		s = strings.Replace(s, "POINT", "", -1)
-		tp, err := parsePoint(trimSpaceBrackets(s))
+		s, cp := parseLayout(strings.Replace(s, "POINT", "", -1))
+		c, err := cp.point(s)
 		if err != nil {
 			return nil, err
 		}
-		geom = tp
+		geom = zm.Point(c, cp.layout)
 
 	case strings.Contains(s, "MULTILINESTRING"):
-		if s == "MULTILINESTRING EMPTY" {
-			return orb.MultiLineString{}, nil
+		s, cp := parseLayout(strings.Replace(s, "MULTILINESTRING", "", -1))
+		if s == "EMPTY" {
+			return zm.MultiLineString(nil, cp.layout), nil
 		}
-		s = strings.Replace(s, "MULTILINESTRING", "", -1)
-		ml := orb.MultiLineString{}
-		for _, l := range strings.Split(trimSpaceBrackets(s), "),(") {
-			tl := orb.LineString{}
-			for _, p := range strings.Split(trimSpaceBrackets(l), ",") {
-				tp, err := parsePoint(trimSpaceBrackets(p))
-				if err != nil {
-					return nil, err
-				}
-				tl = append(tl, tp)
-			}
-			ml = append(ml, tl)
+
+		lines, err := cp.lines(s)
+		if err != nil {
+			return nil, err
 		}
-		geom = ml
+		geom = zm.MultiLineString(lines, cp.layout)
 
 	case strings.Contains(s, "LINESTRING"):
-		if s == "LINESTRING EMPTY" {
-			return orb.LineString{}, nil
+		s, cp := parseLayout(strings.Replace(s, "LINESTRING", "", -1))
+		if s == "EMPTY" {
+			return zm.LineString(nil, cp.layout), nil
 		}
-		s = strings.Replace(s, "LINESTRING", "", -1)
-		s = trimSpaceBrackets(s)
-		ps := strings.Split(s, ",")
-		ls := orb.LineString{}
-		for _, p := range ps {
-			tp, err := parsePoint(trimSpaceBrackets(p))
-			if err != nil {
-				return nil, err
-			}
-			ls = append(ls, tp)
+
+		cs, err := cp.points(s)
+		if err != nil {
+			return nil, err
 		}
-		geom = ls
+		geom = zm.LineString(cs, cp.layout)
 
 	case strings.Contains(s, "MULTIPOLYGON"):
-		if s == "MULTIPOLYGON EMPTY" {
-			return orb.MultiPolygon{}, nil
+		s, cp := parseLayout(strings.Replace(s, "MULTIPOLYGON", "", -1))
+		if s == "EMPTY" {
+			return zm.MultiPolygon(nil, cp.layout), nil
 		}
-		s = strings.Replace(s, "MULTIPOLYGON", "", -1)
-		mpol := orb.MultiPolygon{}
-		for _, ps := range strings.Split(trimSpaceBrackets(s), ")),((") {
-			pol := orb.Polygon{}
-			for _, ls := range strings.Split(trimSpaceBrackets(ps), "),(") {
-				ring := orb.Ring{}
-				for _, p := range strings.Split(ls, ",") {
-					tp, err := parsePoint(trimSpaceBrackets(p))
-					if err != nil {
-						return nil, err
-					}
-					ring = append(ring, tp)
-				}
-				pol = append(pol, ring)
+
+		ps := splitTop(trimSpaceBrackets(s))
+		polygons := make([][][]zm.Coord, 0, len(ps))
+		for _, p := range ps {
+			rings, err := cp.lines(p)
+			if err != nil {
+				return nil, err
 			}
-			mpol = append(mpol, pol)
+			polygons = append(polygons, rings)
 		}
-		geom = mpol
+		geom = zm.MultiPolygon(polygons, cp.layout)
 
 	case strings.Contains(s, "POLYGON"):
-		if s == "POLYGON EMPTY" {
-			return orb.Polygon{}, nil
+		s, cp := parseLayout(strings.Replace(s, "POLYGON", "", -1))
+		if s == "EMPTY" {
+			return zm.Polygon(nil, cp.layout), nil
 		}
-		s = strings.Replace(s, "POLYGON", "", -1)
-		s = trimSpaceBrackets(s)
-
-		rs := strings.Split(s, "),(")
-		pol := make(orb.Polygon, 0, len(rs))
-		for _, r := range rs {
-			ps := strings.Split(trimSpaceBrackets(r), ",")
-			ring := orb.Ring{}
-			for _, p := range ps {
-				tp, err := parsePoint(trimSpaceBrackets(p))
-				if err != nil {
-					return nil, err
-				}
-				ring = append(ring, tp)
-			}
-			pol = append(pol, ring)
+
+		rings, err := cp.lines(s)
+		if err != nil {
+			return nil, err
 		}
-		geom = pol
+		geom = zm.Polygon(rings, cp.layout)
 	default:
 		return nil, ErrUnsupportedGeometry
 	}
@@ -117,7 +117,7 @@ func wkt(buf *bytes.Buffer, geom orb.Geometry) {
 	case orb.Bound:
 		wkt(buf, g.ToPolygon())
 	default:
-		panic("unsupported type")
+		writeZM(buf, geom)
 	}
 }
 
@@ -0,0 +1,113 @@
+package wkt
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+var layoutTags = map[zm.Layout]string{
+	zm.XYZ:  " Z",
+	zm.XYM:  " M",
+	zm.XYZM: " ZM",
+}
+
+// writeZM writes the geometries with z and/or m values,
+// e.g. POINT Z(1 2 3) or LINESTRING ZM(1 2 3 4,5 6 7 8).
+func writeZM(buf *bytes.Buffer, g orb.Geometry) {
+	l := zm.LayoutOf(g)
+	if l == zm.XY {
+		panic("unsupported type")
+	}
+
+	var empty bool
+	switch g.GeoJSONType() {
+	case "MultiPoint", "LineString":
+		empty = len(zm.Points(g)) == 0
+	case "MultiLineString", "Polygon":
+		empty = len(zm.Lines(g)) == 0
+	case "MultiPolygon":
+		empty = len(zm.Polygons(g)) == 0
+	}
+
+	buf.WriteString(typeNames[g.GeoJSONType()])
+	buf.WriteString(layoutTags[l])
+	if empty {
+		buf.WriteString(" EMPTY")
+		return
+	}
+
+	switch g.GeoJSONType() {
+	case "Point":
+		buf.WriteByte('(')
+		writeCoord(buf, zm.Points(g)[0], l)
+		buf.WriteByte(')')
+	case "MultiPoint":
+		buf.WriteByte('(')
+		for i, c := range zm.Points(g) {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+
+			buf.WriteByte('(')
+			writeCoord(buf, c, l)
+			buf.WriteByte(')')
+		}
+		buf.WriteByte(')')
+	case "LineString":
+		writeCoords(buf, zm.Points(g), l)
+	case "MultiLineString", "Polygon":
+		writeLines(buf, zm.Lines(g), l)
+	case "MultiPolygon":
+		buf.WriteByte('(')
+		for i, rings := range zm.Polygons(g) {
+			if i != 0 {
+				buf.WriteByte(',')
+			}
+			writeLines(buf, rings, l)
+		}
+		buf.WriteByte(')')
+	}
+}
+
+var typeNames = map[string]string{
+	"Point":           "POINT",
+	"MultiPoint":      "MULTIPOINT",
+	"LineString":      "LINESTRING",
+	"MultiLineString": "MULTILINESTRING",
+	"Polygon":         "POLYGON",
+	"MultiPolygon":    "MULTIPOLYGON",
+}
+
+func writeCoord(buf *bytes.Buffer, c zm.Coord, l zm.Layout) {
+	for i := 0; i < l.Size(); i++ {
+		if i != 0 {
+			buf.WriteByte(' ')
+		}
+		fmt.Fprintf(buf, "%g", c[i])
+	}
+}
+
+func writeCoords(buf *bytes.Buffer, cs []zm.Coord, l zm.Layout) {
+	buf.WriteByte('(')
+	for i, c := range cs {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		writeCoord(buf, c, l)
+	}
+	buf.WriteByte(')')
+}
+
+func writeLines(buf *bytes.Buffer, lines [][]zm.Coord, l zm.Layout) {
+	buf.WriteByte('(')
+	for i, cs := range lines {
+		if i != 0 {
+			buf.WriteByte(',')
+		}
+		writeCoords(buf, cs, l)
+	}
+	buf.WriteByte(')')
+}
@@ -0,0 +1,181 @@
+package wkt
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestMarshalString_zm(t *testing.T) {
+	cases := []struct {
+		name     string
+		geo      orb.Geometry
+		expected string
+	}{
+		{
+			name:     "point z",
+			geo:      orb.PointZ{1, 2, 3},
+			expected: "POINT Z(1 2 3)",
+		},
+		{
+			name:     "point m",
+			geo:      orb.PointM{1, 2, 4},
+			expected: "POINT M(1 2 4)",
+		},
+		{
+			name:     "point zm",
+			geo:      orb.PointZM{1, 2, 3, 4},
+			expected: "POINT ZM(1 2 3 4)",
+		},
+		{
+			name:     "multipoint z",
+			geo:      orb.MultiPointZ{{1, 2, 3}, {4, 5, 6}},
+			expected: "MULTIPOINT Z((1 2 3),(4 5 6))",
+		},
+		{
+			name:     "multipoint m empty",
+			geo:      orb.MultiPointM{},
+			expected: "MULTIPOINT M EMPTY",
+		},
+		{
+			name:     "linestring m",
+			geo:      orb.LineStringM{{1, 2, 3}, {4, 5, 6}},
+			expected: "LINESTRING M(1 2 3,4 5 6)",
+		},
+		{
+			name:     "multilinestring zm",
+			geo:      orb.MultiLineStringZM{{{1, 2, 3, 4}}, {{5, 6, 7, 8}, {9, 10, 11, 12}}},
+			expected: "MULTILINESTRING ZM((1 2 3 4),(5 6 7 8,9 10 11 12))",
+		},
+		{
+			name:     "polygon z",
+			geo:      orb.PolygonZ{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}},
+			expected: "POLYGON Z((0 0 1,1 0 2,1 1 3,0 0 1))",
+		},
+		{
+			name:     "multipolygon z",
+			geo:      orb.MultiPolygonZ{{{{0, 0, 1}, {1, 0, 2}, {0, 0, 1}}}, {{{5, 5, 5}}}},
+			expected: "MULTIPOLYGON Z(((0 0 1,1 0 2,0 0 1)),((5 5 5)))",
+		},
+		{
+			name:     "multipolygon zm empty",
+			geo:      orb.MultiPolygonZM{},
+			expected: "MULTIPOLYGON ZM EMPTY",
+		},
+		{
+			name:     "collection",
+			geo:      orb.Collection{orb.PointM{1, 2, 3}, orb.Point{4, 5}},
+			expected: "GEOMETRYCOLLECTION(POINT M(1 2 3),POINT(4 5))",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := MarshalString(tc.geo)
+			if v != tc.expected {
+				t.Log(v)
+				t.Log(tc.expected)
+				t.Errorf("incorrect wkt marshalling")
+			}
+
+			g, err := Unmarshal(v)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			if !orb.Equal(g, tc.geo) {
+				t.Errorf("incorrect round trip: %v", g)
+			}
+		})
+	}
+}
+
+func TestUnmarshal_zm(t *testing.T) {
+	cases := []struct {
+		name     string
+		s        string
+		expected orb.Geometry
+	}{
+		{
+			name:     "untagged 3 values is z",
+			s:        "POINT(1 2 3)",
+			expected: orb.PointZ{1, 2, 3},
+		},
+		{
+			name:     "untagged 4 values is zm",
+			s:        "LINESTRING(1 2 3 4,5 6 7 8)",
+			expected: orb.LineStringZM{{1, 2, 3, 4}, {5, 6, 7, 8}},
+		},
+		{
+			name:     "space before bracket",
+			s:        "point m (1 2 3)",
+			expected: orb.PointM{1, 2, 3},
+		},
+		{
+			name:     "polygon with spaces",
+			s:        "POLYGON Z ((0 0 1, 1 0 2, 1 1 3, 0 0 1), (0 0 1, 0 0 1))",
+			expected: orb.PolygonZ{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}, {{0, 0, 1}, {0, 0, 1}}},
+		},
+		{
+			name:     "linestring z empty",
+			s:        "LINESTRING Z EMPTY",
+			expected: orb.LineStringZ{},
+		},
+		{
+			name:     "collection",
+			s:        "GEOMETRYCOLLECTION Z(POINT Z(1 2 3),MULTIPOINT M((1 2 3)))",
+			expected: orb.Collection{orb.PointZ{1, 2, 3}, orb.MultiPointM{{1, 2, 3}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			g, err := Unmarshal(tc.s)
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			if !orb.Equal(g, tc.expected) {
+				t.Errorf("incorrect geometry: %v", g)
+			}
+		})
+	}
+}
+
+func TestUnmarshal_zmErrors(t *testing.T) {
+	cases := []string{
+		"POINT Z(1 2)",
+		"POINT ZM(1 2 3)",
+		"LINESTRING(1 2 3,4 5)",
+		"POINT(1 2 3 4 5)",
+	}
+
+	for _, s := range cases {
+		t.Run(s, func(t *testing.T) {
+			_, err := Unmarshal(s)
+			if err != ErrNotWKT {
+				t.Errorf("incorrect error: %v", err)
+			}
+		})
+	}
+}
+
+func TestUnmarshalPoint_dropsZM(t *testing.T) {
+	p, err := UnmarshalPoint("POINT ZM(1 2 3 4)")
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !p.Equal(orb.Point{1, 2}) {
+		t.Errorf("incorrect point: %v", p)
+	}
+
+	mp, err := UnmarshalMultiPolygon("MULTIPOLYGON Z(((0 0 1,1 0 2,0 0 1)))")
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !mp.Equal(orb.MultiPolygon{{{{0, 0}, {1, 0}, {0, 0}}}}) {
+		t.Errorf("incorrect multi polygon: %v", mp)
+	}
+}
@@ -16,13 +16,29 @@ func Equal(g1, g2 Geometry) bool {
 
 	switch g1 := g1.(type) {
 	case Point:
-		return g1.Equal(g2.(Point))
+		g2, ok := g2.(Point)
+		if !ok {
+			return false
+		}
+		return g1.Equal(g2)
 	case MultiPoint:
-		return g1.Equal(g2.(MultiPoint))
+		g2, ok := g2.(MultiPoint)
+		if !ok {
+			return false
+		}
+		return g1.Equal(g2)
 	case LineString:
-		return g1.Equal(g2.(LineString))
+		g2, ok := g2.(LineString)
+		if !ok {
+			return false
+		}
+		return g1.Equal(g2)
 	case MultiLineString:
-		return g1.Equal(g2.(MultiLineString))
+		g2, ok := g2.(MultiLineString)
+		if !ok {
+			return false
+		}
+		return g1.Equal(g2)
 	case Ring:
 		g2, ok := g2.(Ring)
 		if !ok {
@@ -36,9 +52,17 @@ func Equal(g1, g2 Geometry) bool {
 		}
 		return g1.Equal(g2)
 	case MultiPolygon:
-		return g1.Equal(g2.(MultiPolygon))
+		g2, ok := g2.(MultiPolygon)
+		if !ok {
+			return false
+		}
+		return g1.Equal(g2)
 	case Collection:
-		return g1.Equal(g2.(Collection))
+		g2, ok := g2.(Collection)
+		if !ok {
+			return false
+		}
+		return g1.Equal(g2)
 	case Bound:
 		g2, ok := g2.(Bound)
 		if !ok {
@@ -47,5 +71,9 @@ func Equal(g1, g2 Geometry) bool {
 		return g1.Equal(g2)
 	}
 
+	if eq, ok := equalZM(g1, g2); ok {
+		return eq
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g1))
 }
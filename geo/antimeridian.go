@@ -0,0 +1,286 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/clip"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// CutAntimeridian splits the geometry at the antimeridian, as RFC 7946
+// section 3.1.9 recommends for GeoJSON. Segments with longitudes more than
+// 180 degrees apart are assumed to cross the antimeridian, going the shorter
+// way around the earth. Lines that cross are returned as a MultiLineString
+// and polygons as a MultiPolygon with the parts on either side ending at
+// 180 and -180. The latitude where a segment crosses is interpolated
+// linearly. Polygons that go around a pole are closed through the pole on
+// the side of their average latitude. Geometries that do not cross the
+// antimeridian are returned unchanged. The input is not modified.
+func CutAntimeridian(g orb.Geometry) orb.Geometry {
+	switch g := g.(type) {
+	case nil:
+		return nil
+	case orb.Point, orb.MultiPoint, orb.Bound:
+		return g
+	case orb.LineString:
+		if !crossesAntimeridian(g, false) {
+			return g
+		}
+		return cutLineString(nil, g)
+	case orb.MultiLineString:
+		if !anyCrossesAntimeridian(g, false) {
+			return g
+		}
+
+		var result orb.MultiLineString
+		for _, ls := range g {
+			result = cutLineString(result, ls)
+		}
+		return result
+	case orb.Ring:
+		if !crossesAntimeridian(g, true) {
+			return g
+		}
+		return cutPolygon(nil, orb.Polygon{g})
+	case orb.Polygon:
+		if !polygonCrossesAntimeridian(g) {
+			return g
+		}
+		return cutPolygon(nil, g)
+	case orb.MultiPolygon:
+		crosses := false
+		for _, p := range g {
+			crosses = crosses || polygonCrossesAntimeridian(p)
+		}
+
+		if !crosses {
+			return g
+		}
+
+		var result orb.MultiPolygon
+		for _, p := range g {
+			if polygonCrossesAntimeridian(p) {
+				result = cutPolygon(result, p)
+			} else {
+				result = append(result, p)
+			}
+		}
+		return result
+	case orb.Collection:
+		if g == nil {
+			return g
+		}
+
+		result := make(orb.Collection, 0, len(g))
+		for _, c := range g {
+			result = append(result, CutAntimeridian(c))
+		}
+		return result
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return CutAntimeridian(zm.ToXY(g))
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+// crossesAntimeridian returns true if any segment of the line crosses
+// the antimeridian. Rings are implicitly closed.
+func crossesAntimeridian(ls []orb.Point, ring bool) bool {
+	for i := 1; i < len(ls); i++ {
+		if math.Abs(ls[i][0]-ls[i-1][0]) > 180 {
+			return true
+		}
+	}
+
+	if ring && len(ls) > 1 {
+		return math.Abs(ls[0][0]-ls[len(ls)-1][0]) > 180
+	}
+
+	return false
+}
+
+func anyCrossesAntimeridian(mls orb.MultiLineString, ring bool) bool {
+	for _, ls := range mls {
+		if crossesAntimeridian(ls, ring) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func polygonCrossesAntimeridian(p orb.Polygon) bool {
+	for _, r := range p {
+		if crossesAntimeridian(r, true) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// cutLineString adds the parts of the line between the crossings
+// of the antimeridian.
+func cutLineString(result orb.MultiLineString, ls orb.LineString) orb.MultiLineString {
+	var current orb.LineString
+	add := func(p orb.Point) {
+		if len(current) == 0 || current[len(current)-1] != p {
+			current = append(current, p)
+		}
+	}
+
+	for i, p := range ls {
+		if i > 0 && math.Abs(p[0]-ls[i-1][0]) > 180 {
+			prev := ls[i-1]
+			edge := math.Copysign(180, prev[0])
+
+			// move p to the same side as prev
+			lon := p[0] + math.Copysign(360, prev[0])
+			t := (edge - prev[0]) / (lon - prev[0])
+			lat := prev[1] + t*(p[1]-prev[1])
+
+			add(orb.Point{edge, lat})
+			if len(current) >= 2 {
+				result = append(result, current)
+			}
+
+			current = nil
+			add(orb.Point{-edge, lat})
+		}
+
+		add(p)
+	}
+
+	if len(current) >= 2 {
+		result = append(result, current)
+	}
+
+	return result
+}
+
+// cutPolygon adds the parts of the polygon on either side of the
+// antimeridian. The rings are unwrapped into continuous longitudes,
+// clipped to each 360 degree wide strip and moved back into [-180, 180].
+func cutPolygon(result orb.MultiPolygon, p orb.Polygon) orb.MultiPolygon {
+	if len(p) == 0 || len(p[0]) == 0 {
+		return result
+	}
+
+	unwrapped := make(orb.Polygon, 0, len(p))
+	for _, r := range p {
+		if len(r) == 0 {
+			continue
+		}
+
+		u := unwrapRing(r)
+		if len(unwrapped) > 0 {
+			// keep holes next to the outer ring
+			c := (u.Bound().Min[0] + u.Bound().Max[0]) / 2
+			oc := (unwrapped[0].Bound().Min[0] + unwrapped[0].Bound().Max[0]) / 2
+			shiftRing(u, -360*math.Round((c-oc)/360))
+		}
+
+		unwrapped = append(unwrapped, u)
+	}
+
+	b := unwrapped[0].Bound()
+	first := math.Floor((b.Min[0] + 180) / 360)
+	last := math.Ceil((b.Max[0] - 180) / 360)
+	for k := first; k <= last; k++ {
+		strip := orb.Bound{
+			Min: orb.Point{-180 + 360*k, -90},
+			Max: orb.Point{180 + 360*k, 90},
+		}
+
+		part := clip.Polygon(strip, unwrapped.Clone())
+		if len(part) == 0 || len(part[0]) < 4 {
+			continue
+		}
+
+		for _, r := range part {
+			shiftRing(r, -360*k)
+		}
+		result = append(result, part)
+	}
+
+	return result
+}
+
+// unwrapRing returns a closed copy of the ring with longitudes that do
+// not jump at the antimeridian, so they may be outside [-180, 180].
+// A ring that goes around a pole is closed along the antimeridian
+// through the pole.
+func unwrapRing(r orb.Ring) orb.Ring {
+	if len(r) > 1 && r[0] == r[len(r)-1] {
+		r = r[:len(r)-1]
+	}
+
+	u := make(orb.Ring, 1, len(r)+5)
+	u[0] = r[0]
+
+	avgLat := r[0][1]
+	for i := 1; i < len(r); i++ {
+		lon := u[i-1][0] + math.Remainder(r[i][0]-r[i-1][0], 360)
+		u = append(u, orb.Point{lon, r[i][1]})
+		avgLat += r[i][1]
+	}
+	avgLat /= float64(len(r))
+
+	last := u[len(u)-1]
+	next := last[0] + math.Remainder(r[0][0]-r[len(r)-1][0], 360)
+	if net := next - u[0][0]; math.Abs(net) > 180 {
+		// around a pole, start after a crossing so the closing segment
+		// crosses too and go along the antimeridian, across the pole
+		// and back from there.
+		if start := firstCrossing(r); start != 0 {
+			rotated := make(orb.Ring, 0, len(r))
+			rotated = append(rotated, r[start:]...)
+			return unwrapRing(append(rotated, r[:start]...))
+		}
+
+		var edge float64
+		if net > 0 {
+			edge = 360*math.Ceil((last[0]-180)/360) + 180
+		} else {
+			edge = 360*math.Floor((last[0]+180)/360) - 180
+		}
+
+		lat := last[1] + (edge-last[0])/(next-last[0])*(r[0][1]-last[1])
+		pole := math.Copysign(90, avgLat)
+		u = append(u,
+			orb.Point{edge, lat},
+			orb.Point{edge, pole},
+			orb.Point{edge - net, pole},
+			orb.Point{edge - net, lat},
+		)
+	}
+
+	return append(u, u[0])
+}
+
+// firstCrossing returns the index of the first point after a segment of
+// the open ring that crosses the antimeridian. Returns 0 if none do,
+// then only the closing segment can cross.
+func firstCrossing(r orb.Ring) int {
+	for i := 1; i < len(r); i++ {
+		if math.Abs(r[i][0]-r[i-1][0]) > 180 {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func shiftRing(r orb.Ring, dlon float64) {
+	if dlon == 0 {
+		return
+	}
+
+	for i := range r {
+		r[i][0] += dlon
+	}
+}
@@ -0,0 +1,155 @@
+package geo
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+func TestCutAntimeridian(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		// should not panic with unsupported type
+		CutAntimeridian(g)
+	}
+
+	cases := []struct {
+		name     string
+		input    orb.Geometry
+		expected orb.Geometry
+	}{
+		{
+			name:     "point",
+			input:    orb.Point{180, 0},
+			expected: orb.Point{180, 0},
+		},
+		{
+			name:     "line that does not cross",
+			input:    orb.LineString{{170, 0}, {-10, 0}},
+			expected: orb.LineString{{170, 0}, {-10, 0}},
+		},
+		{
+			name:  "line",
+			input: orb.LineString{{170, 0}, {-170, 10}, {-160, 10}, {170, 10}},
+			expected: orb.MultiLineString{
+				{{170, 0}, {180, 5}},
+				{{-180, 5}, {-170, 10}, {-160, 10}, {-180, 10}},
+				{{180, 10}, {170, 10}},
+			},
+		},
+		{
+			name:  "line from the antimeridian",
+			input: orb.LineString{{180, 0}, {-170, 0}},
+			expected: orb.MultiLineString{
+				{{-180, 0}, {-170, 0}},
+			},
+		},
+		{
+			name: "multi line string",
+			input: orb.MultiLineString{
+				{{0, 0}, {1, 1}},
+				{{-170, 0}, {170, 0}},
+			},
+			expected: orb.MultiLineString{
+				{{0, 0}, {1, 1}},
+				{{-170, 0}, {-180, 0}},
+				{{180, 0}, {170, 0}},
+			},
+		},
+		{
+			name:  "fiji",
+			input: orb.Polygon{{{178, -16}, {-178, -16}, {-178, -18}, {178, -18}, {178, -16}}},
+			expected: orb.MultiPolygon{
+				{{{178, -16}, {180, -16}, {180, -18}, {178, -18}, {178, -16}}},
+				{{{-180, -16}, {-178, -16}, {-178, -18}, {-180, -18}, {-180, -16}}},
+			},
+		},
+		{
+			name:  "ring",
+			input: orb.Ring{{178, -16}, {-178, -16}, {-178, -18}, {178, -18}},
+			expected: orb.MultiPolygon{
+				{{{178, -16}, {180, -16}, {180, -18}, {178, -18}, {178, -16}}},
+				{{{-180, -16}, {-178, -16}, {-178, -18}, {-180, -18}, {-180, -16}}},
+			},
+		},
+		{
+			name: "multi polygon",
+			input: orb.MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+				{{{178, -16}, {-178, -16}, {-178, -18}, {178, -18}, {178, -16}}},
+			},
+			expected: orb.MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+				{{{178, -16}, {180, -16}, {180, -18}, {178, -18}, {178, -16}}},
+				{{{-180, -16}, {-178, -16}, {-178, -18}, {-180, -18}, {-180, -16}}},
+			},
+		},
+		{
+			name: "collection",
+			input: orb.Collection{
+				orb.Point{1, 2},
+				orb.LineString{{170, 0}, {-170, 0}},
+			},
+			expected: orb.Collection{
+				orb.Point{1, 2},
+				orb.MultiLineString{{{170, 0}, {180, 0}}, {{-180, 0}, {-170, 0}}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := CutAntimeridian(tc.input)
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("incorrect result")
+				t.Logf("%v", result)
+				t.Logf("%v", tc.expected)
+			}
+		})
+	}
+}
+
+func TestCutAntimeridian_hole(t *testing.T) {
+	p := orb.Polygon{
+		{{170, -20}, {-170, -20}, {-170, 0}, {170, 0}, {170, -20}},
+		{{179, -11}, {179, -9}, {-179, -9}, {-179, -11}, {179, -11}},
+	}
+
+	result, ok := CutAntimeridian(p).(orb.MultiPolygon)
+	if !ok || len(result) != 2 {
+		t.Fatalf("should be split in 2: %v", result)
+	}
+
+	for _, part := range result {
+		if len(part) != 2 {
+			t.Errorf("each part should have half the hole: %v", part)
+		}
+
+		if a := planar.Area(part); math.Abs(a-198) > 1e-9 {
+			t.Errorf("incorrect area: %v", a)
+		}
+	}
+}
+
+func TestCutAntimeridian_pole(t *testing.T) {
+	result, ok := CutAntimeridian(antarctica).(orb.MultiPolygon)
+	if !ok || len(result) != 1 {
+		t.Fatalf("should be one polygon: %v", result)
+	}
+
+	b := result.Bound()
+	if b.Min[0] != -180 || b.Max[0] != 180 || b.Min[1] != -90 {
+		t.Errorf("should go along the antimeridian and through the pole: %v", b)
+	}
+
+	if len(result[0][0]) != len(antarctica)+5 {
+		t.Errorf("should add 4 points and close the ring: %v", result[0][0])
+	}
+
+	// the input should not be modified
+	if antarctica[0] != (orb.Point{-58, -63.1}) {
+		t.Errorf("input modified: %v", antarctica)
+	}
+}
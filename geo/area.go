@@ -6,9 +6,12 @@ import (
 	"math"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 // Area returns the area of the geometry on the earth.
+// It uses a spherical approximation, see AreaGeodesic for a more accurate
+// version on the WGS84 ellipsoid.
 func Area(g orb.Geometry) float64 {
 	if g == nil {
 		return 0
@@ -29,6 +32,10 @@ func Area(g orb.Geometry) float64 {
 		return Area(g.ToRing())
 	}
 
+	if zm.LayoutOf(g) != zm.XY {
+		return Area(zm.ToXY(g))
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g))
 }
 
@@ -0,0 +1,160 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// Densify returns a copy of the geometry with extra points along the great
+// circle path of every segment longer than maxSegmentMeters, so no segment
+// is longer than that. The segments are split into equal parts. This makes
+// lines, like flight routes, follow the shortest path when drawn on a map.
+// New points are kept in [-180, 180], so paths across the antimeridian
+// jump there like in the input, use CutAntimeridian to split them.
+// Points and bounds are returned unchanged, as is the geometry if
+// maxSegmentMeters is not positive.
+func Densify(g orb.Geometry, maxSegmentMeters float64) orb.Geometry {
+	if g == nil || !(maxSegmentMeters > 0) {
+		return g
+	}
+
+	switch g := g.(type) {
+	case orb.Point, orb.MultiPoint, orb.Bound:
+		return g
+	case orb.LineString:
+		return orb.LineString(densify(g, maxSegmentMeters))
+	case orb.MultiLineString:
+		if g == nil {
+			return g
+		}
+
+		result := make(orb.MultiLineString, 0, len(g))
+		for _, ls := range g {
+			result = append(result, densify(ls, maxSegmentMeters))
+		}
+		return result
+	case orb.Ring:
+		return orb.Ring(densify(g, maxSegmentMeters))
+	case orb.Polygon:
+		return densifyPolygon(g, maxSegmentMeters)
+	case orb.MultiPolygon:
+		if g == nil {
+			return g
+		}
+
+		result := make(orb.MultiPolygon, 0, len(g))
+		for _, p := range g {
+			result = append(result, densifyPolygon(p, maxSegmentMeters))
+		}
+		return result
+	case orb.Collection:
+		if g == nil {
+			return g
+		}
+
+		result := make(orb.Collection, 0, len(g))
+		for _, c := range g {
+			result = append(result, Densify(c, maxSegmentMeters))
+		}
+		return result
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return Densify(zm.ToXY(g), maxSegmentMeters)
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+func densifyPolygon(p orb.Polygon, max float64) orb.Polygon {
+	if p == nil {
+		return nil
+	}
+
+	result := make(orb.Polygon, 0, len(p))
+	for _, r := range p {
+		result = append(result, densify(r, max))
+	}
+
+	return result
+}
+
+// densify returns a copy of the points with the long segments split.
+func densify(ls []orb.Point, max float64) []orb.Point {
+	if ls == nil {
+		return nil
+	}
+
+	result := make([]orb.Point, 0, len(ls))
+	for i, p := range ls {
+		if i > 0 {
+			prev := ls[i-1]
+			n := math.Ceil(DistanceHaversine(prev, p) / max)
+			for j := 1.0; j < n; j++ {
+				result = append(result, intermediatePoint(prev, p, j/n))
+			}
+		}
+
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// IntermediatePoint returns the point at the fraction of the way along
+// the great circle path between the two points. The longitude is kept
+// within 180 degrees of the first point so it can be outside [-180, 180]
+// if the path crosses the antimeridian. Callers that need a longitude
+// in [-180, 180] must normalize it.
+func IntermediatePoint(p1, p2 orb.Point, fraction float64) orb.Point {
+	if fraction == 0 {
+		return p1
+	}
+
+	if fraction == 1 {
+		return p2
+	}
+
+	d := DistanceHaversine(p1, p2) / orb.EarthRadius
+	if d == 0 {
+		return p1
+	}
+
+	lat1, lon1 := deg2rad(p1[1]), deg2rad(p1[0])
+	lat2, lon2 := deg2rad(p2[1]), deg2rad(p2[0])
+
+	a := math.Sin((1-fraction)*d) / math.Sin(d)
+	b := math.Sin(fraction*d) / math.Sin(d)
+
+	x := a*math.Cos(lat1)*math.Cos(lon1) + b*math.Cos(lat2)*math.Cos(lon2)
+	y := a*math.Cos(lat1)*math.Sin(lon1) + b*math.Cos(lat2)*math.Sin(lon2)
+	z := a*math.Sin(lat1) + b*math.Sin(lat2)
+
+	lon := rad2deg(math.Atan2(y, x))
+	lat := rad2deg(math.Atan2(z, math.Sqrt(x*x+y*y)))
+
+	// keep the longitude near the first point so lines across
+	// the antimeridian stay continuous
+	for lon-p1[0] > 180 {
+		lon -= 360
+	}
+	for lon-p1[0] < -180 {
+		lon += 360
+	}
+
+	return orb.Point{lon, lat}
+}
+
+// intermediatePoint is the same as IntermediatePoint but the longitude
+// is normalized to [-180, 180] if the path crosses the antimeridian.
+func intermediatePoint(p1, p2 orb.Point, fraction float64) orb.Point {
+	p := IntermediatePoint(p1, p2, fraction)
+	if math.Abs(p[0]) > 180 {
+		p[0] = angNormalize(p[0])
+	}
+
+	return p
+}
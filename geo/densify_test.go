@@ -0,0 +1,196 @@
+package geo
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestIntermediatePoint(t *testing.T) {
+	cases := []struct {
+		name     string
+		p1, p2   orb.Point
+		fraction float64
+		expected orb.Point
+	}{
+		{
+			name:     "start",
+			p1:       orb.Point{1, 2},
+			p2:       orb.Point{3, 4},
+			fraction: 0,
+			expected: orb.Point{1, 2},
+		},
+		{
+			name:     "end",
+			p1:       orb.Point{1, 2},
+			p2:       orb.Point{3, 4},
+			fraction: 1,
+			expected: orb.Point{3, 4},
+		},
+		{
+			name:     "along the equator",
+			p1:       orb.Point{0, 0},
+			p2:       orb.Point{90, 0},
+			fraction: 0.5,
+			expected: orb.Point{45, 0},
+		},
+		{
+			name:     "along a meridian",
+			p1:       orb.Point{10, 0},
+			p2:       orb.Point{10, 60},
+			fraction: 0.25,
+			expected: orb.Point{10, 15},
+		},
+		{
+			name:     "over the pole",
+			p1:       orb.Point{0, 80},
+			p2:       orb.Point{180, 80},
+			fraction: 0.25,
+			expected: orb.Point{0, 85},
+		},
+		{
+			name:     "across the antimeridian",
+			p1:       orb.Point{170, 0},
+			p2:       orb.Point{-170, 0},
+			fraction: 0.75,
+			expected: orb.Point{185, 0},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := IntermediatePoint(tc.p1, tc.p2, tc.fraction)
+			if math.Abs(p[0]-tc.expected[0]) > epsilon || math.Abs(p[1]-tc.expected[1]) > epsilon {
+				t.Errorf("incorrect point: %v != %v", p, tc.expected)
+			}
+		})
+	}
+}
+
+func TestIntermediatePoint_greatCircle(t *testing.T) {
+	// the path between points at the same latitude bends towards the pole
+	p := IntermediatePoint(orb.Point{-60, 50}, orb.Point{60, 50}, 0.5)
+	if p[0] != 0 || p[1] <= 60 {
+		t.Errorf("should be north of the parallel: %v", p)
+	}
+}
+
+func TestDensify(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		// should not panic with unsupported type
+		Densify(g, 1000)
+	}
+
+	// one degree along the equator
+	deg := DistanceHaversine(orb.Point{0, 0}, orb.Point{1, 0})
+
+	cases := []struct {
+		name     string
+		input    orb.Geometry
+		max      float64
+		expected orb.Geometry
+	}{
+		{
+			name:     "short segments are unchanged",
+			input:    orb.LineString{{0, 0}, {1, 0}},
+			max:      2 * deg,
+			expected: orb.LineString{{0, 0}, {1, 0}},
+		},
+		{
+			name:     "line string",
+			input:    orb.LineString{{0, 0}, {3, 0}, {3, 0}},
+			max:      1.2 * deg,
+			expected: orb.LineString{{0, 0}, {1, 0}, {2, 0}, {3, 0}, {3, 0}},
+		},
+		{
+			name:     "across the antimeridian",
+			input:    orb.LineString{{178, 0}, {-178, 0}},
+			max:      1.2 * deg,
+			expected: orb.LineString{{178, 0}, {179, 0}, {180, 0}, {-179, 0}, {-178, 0}},
+		},
+		{
+			name:     "multi line string",
+			input:    orb.MultiLineString{{{0, 0}, {2, 0}}, {{5, 5}}},
+			max:      1.5 * deg,
+			expected: orb.MultiLineString{{{0, 0}, {1, 0}, {2, 0}}, {{5, 5}}},
+		},
+		{
+			name:     "ring",
+			input:    orb.Ring{{0, 0}, {2, 0}, {2, 1}, {1, 1}, {0, 1}, {0, 0}},
+			max:      1.5 * deg,
+			expected: orb.Ring{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {1, 1}, {0, 1}, {0, 0}},
+		},
+		{
+			name:     "point",
+			input:    orb.Point{1, 2},
+			max:      1,
+			expected: orb.Point{1, 2},
+		},
+		{
+			name:     "not positive",
+			input:    orb.LineString{{0, 0}, {3, 0}},
+			max:      0,
+			expected: orb.LineString{{0, 0}, {3, 0}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := Densify(tc.input, tc.max)
+			if !geometryWithin(result, tc.expected, epsilon) {
+				t.Errorf("incorrect result")
+				t.Logf("%v", result)
+				t.Logf("%v", tc.expected)
+			}
+		})
+	}
+}
+
+func TestDensify_polygon(t *testing.T) {
+	p := orb.Polygon{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{4, 4}, {4, 6}, {6, 6}, {6, 4}, {4, 4}},
+	}
+	expected := p.Clone()
+
+	mp := orb.MultiPolygon{p}
+	result := Densify(mp, 300000).(orb.MultiPolygon)
+	if !p.Equal(expected) {
+		t.Errorf("input modified: %v", p)
+	}
+
+	for _, r := range result[0] {
+		for i := 1; i < len(r); i++ {
+			if d := DistanceHaversine(r[i-1], r[i]); d > 300000 {
+				t.Errorf("segment too long: %v", d)
+			}
+		}
+	}
+
+	if len(result[0][1]) != len(p[1]) {
+		t.Errorf("short hole segments should be unchanged: %v", result[0][1])
+	}
+}
+
+// geometryWithin compares the points of the geometries within the tolerance.
+func geometryWithin(g1, g2 orb.Geometry, tolerance float64) bool {
+	if reflect.TypeOf(g1) != reflect.TypeOf(g2) {
+		return false
+	}
+
+	p1 := geometryPoints(nil, g1)
+	p2 := geometryPoints(nil, g2)
+	if len(p1) != len(p2) {
+		return false
+	}
+
+	for i := range p1 {
+		if math.Abs(p1[i][0]-p2[i][0]) > tolerance || math.Abs(p1[i][1]-p2[i][1]) > tolerance {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,112 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// DistanceFrom returns the distance in meters from the point to the
+// boundary of the geometry. The line segments are great circle paths and
+// the distance is the cross-track distance on the sphere, or the distance
+// to the closest end point. Returns +Inf for empty geometries.
+func DistanceFrom(g orb.Geometry, p orb.Point) float64 {
+	if g == nil {
+		return math.Inf(1)
+	}
+
+	switch g := g.(type) {
+	case orb.Point:
+		return DistanceHaversine(g, p)
+	case orb.MultiPoint:
+		dist := math.Inf(1)
+		for _, mp := range g {
+			dist = math.Min(dist, DistanceHaversine(mp, p))
+		}
+		return dist
+	case orb.LineString:
+		d, _, _ := lineStringDistanceFrom(g, p)
+		return d
+	case orb.MultiLineString:
+		dist := math.Inf(1)
+		for _, ls := range g {
+			d, _, _ := lineStringDistanceFrom(ls, p)
+			dist = math.Min(dist, d)
+		}
+		return dist
+	case orb.Ring:
+		d, _, _ := lineStringDistanceFrom(orb.LineString(g), p)
+		return d
+	case orb.Polygon:
+		return polygonDistanceFrom(g, p)
+	case orb.MultiPolygon:
+		dist := math.Inf(1)
+		for _, poly := range g {
+			dist = math.Min(dist, polygonDistanceFrom(poly, p))
+		}
+		return dist
+	case orb.Collection:
+		dist := math.Inf(1)
+		for _, c := range g {
+			dist = math.Min(dist, DistanceFrom(c, p))
+		}
+		return dist
+	case orb.Bound:
+		return DistanceFrom(g.ToRing(), p)
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return DistanceFrom(zm.ToXY(g), p)
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+// ClosestPoint returns the point on the line string closest to p. The line
+// segments are great circle paths. Returns the zero point for empty lines.
+// The longitude of the point is kept in [-180, 180].
+func ClosestPoint(ls orb.LineString, p orb.Point) orb.Point {
+	if len(ls) == 0 {
+		return orb.Point{}
+	}
+
+	if len(ls) == 1 {
+		return ls[0]
+	}
+
+	_, i, t := lineStringDistanceFrom(ls, p)
+	return intermediatePoint(ls[i], ls[i+1], t)
+}
+
+// lineStringDistanceFrom returns the distance to the closest segment,
+// the index of that segment and the fraction along it of the closest point.
+func lineStringDistanceFrom(ls orb.LineString, p orb.Point) (float64, int, float64) {
+	if len(ls) == 1 {
+		return DistanceHaversine(ls[0], p), 0, 0
+	}
+
+	dist := math.Inf(1)
+	index := -1
+	fraction := 0.0
+	for i := 0; i < len(ls)-1; i++ {
+		if t, d := closestOnSegment(ls[i], ls[i+1], p); d < dist {
+			dist = d
+			index = i
+			fraction = t
+		}
+	}
+
+	return dist, index, fraction
+}
+
+func polygonDistanceFrom(p orb.Polygon, point orb.Point) float64 {
+	dist := math.Inf(1)
+	for _, r := range p {
+		d, _, _ := lineStringDistanceFrom(orb.LineString(r), point)
+		dist = math.Min(dist, d)
+	}
+
+	return dist
+}
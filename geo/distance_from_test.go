@@ -0,0 +1,160 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestDistanceFrom(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		// should not panic with unsupported type
+		DistanceFrom(g, orb.Point{})
+	}
+
+	// one degree along the equator
+	deg := DistanceHaversine(orb.Point{0, 0}, orb.Point{1, 0})
+
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		point    orb.Point
+		expected float64
+	}{
+		{
+			name:     "point",
+			geom:     orb.Point{1, 0},
+			point:    orb.Point{0, 0},
+			expected: deg,
+		},
+		{
+			name:     "multi point",
+			geom:     orb.MultiPoint{{5, 0}, {1, 0}},
+			point:    orb.Point{0, 0},
+			expected: deg,
+		},
+		{
+			name:     "along a meridian",
+			geom:     orb.LineString{{0, -10}, {0, 10}},
+			point:    orb.Point{1, 0},
+			expected: deg,
+		},
+		{
+			name:     "past the end",
+			geom:     orb.LineString{{0, 0}, {0, 10}},
+			point:    orb.Point{0, -1},
+			expected: deg,
+		},
+		{
+			name:     "multi line string",
+			geom:     orb.MultiLineString{{{10, -10}, {10, 10}}, {{0, -10}, {0, 10}}},
+			point:    orb.Point{1, 0},
+			expected: deg,
+		},
+		{
+			name: "inside a polygon",
+			geom: orb.Polygon{
+				{{-10, -10}, {10, -10}, {10, 10}, {-10, 10}, {-10, -10}},
+				{{-1, -1}, {-1, 1}, {1, 1}, {1, -1}, {-1, -1}},
+			},
+			point:    orb.Point{0, 0},
+			expected: deg,
+		},
+		{
+			name: "collection",
+			geom: orb.Collection{
+				orb.Point{20, 0},
+				orb.LineString{{0, -10}, {0, 10}},
+			},
+			point:    orb.Point{-1, 0},
+			expected: deg,
+		},
+		{
+			name:     "empty",
+			geom:     orb.LineString{},
+			point:    orb.Point{},
+			expected: math.Inf(1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := DistanceFrom(tc.geom, tc.point)
+			if math.Abs(d-tc.expected) > epsilon && d != tc.expected {
+				t.Errorf("incorrect distance: %v != %v", d, tc.expected)
+			}
+		})
+	}
+}
+
+func TestDistanceFrom_greatCircle(t *testing.T) {
+	// the great circle between the points bends north of the parallel
+	ls := orb.LineString{{-60, 50}, {60, 50}}
+
+	if d := DistanceFrom(ls, orb.Point{0, 50}); d < 1e6 {
+		t.Errorf("parallel should be far from the great circle: %v", d)
+	}
+
+	mid := IntermediatePoint(ls[0], ls[1], 0.5)
+	if d := DistanceFrom(ls, mid); d > epsilon {
+		t.Errorf("midpoint should be on the line: %v", d)
+	}
+}
+
+func TestClosestPoint(t *testing.T) {
+	cases := []struct {
+		name     string
+		ls       orb.LineString
+		point    orb.Point
+		expected orb.Point
+	}{
+		{
+			name:     "on a meridian",
+			ls:       orb.LineString{{0, -10}, {0, 10}},
+			point:    orb.Point{1, 0},
+			expected: orb.Point{0, 0},
+		},
+		{
+			name:     "past the end",
+			ls:       orb.LineString{{0, 0}, {0, 10}, {10, 10}},
+			point:    orb.Point{0, -5},
+			expected: orb.Point{0, 0},
+		},
+		{
+			// the perpendicular great circle is not the parallel,
+			// lat = atan(tan(15°) / cos(1°))
+			name:     "second segment",
+			ls:       orb.LineString{{0, 0}, {0, 10}, {0, 20}},
+			point:    orb.Point{1, 15},
+			expected: orb.Point{0, 15.002182},
+		},
+		{
+			name:     "across the antimeridian",
+			ls:       orb.LineString{{179, 0}, {-179, 0}},
+			point:    orb.Point{-179.5, 0.1},
+			expected: orb.Point{-179.5, 0},
+		},
+		{
+			name:     "one point",
+			ls:       orb.LineString{{1, 2}},
+			point:    orb.Point{3, 4},
+			expected: orb.Point{1, 2},
+		},
+		{
+			name:     "empty",
+			ls:       orb.LineString{},
+			point:    orb.Point{3, 4},
+			expected: orb.Point{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := ClosestPoint(tc.ls, tc.point)
+			if math.Abs(p[0]-tc.expected[0]) > epsilon || math.Abs(p[1]-tc.expected[1]) > epsilon {
+				t.Errorf("incorrect point: %v != %v", p, tc.expected)
+			}
+		})
+	}
+}
@@ -24,6 +24,46 @@ func ExampleArea() {
 	// 6073.368008 m^2
 }
 
+func ExampleAreaGeodesic() {
+	// a triangle covering an eighth of the earth
+	octant := orb.Polygon{{{0, 0}, {90, 0}, {0, 90}, {0, 0}}}
+
+	fmt.Printf("area: %0.0f km^2\n", geo.AreaGeodesic(octant)/1e6)
+	fmt.Printf("perimeter: %0.0f km\n", geo.PerimeterGeodesic(octant)/1e3)
+	// Output:
+	// area: 63758203 km^2
+	// perimeter: 30023 km
+}
+
+func ExampleBoundOf() {
+	fiji := orb.Polygon{{{178, -16}, {-178, -16}, {-178, -18}, {178, -18}, {178, -16}}}
+
+	b := geo.BoundOf(fiji)
+	fmt.Println(b, b.CrossesAntimeridian())
+	fmt.Println(fiji.Bound())
+	// Output:
+	// {[178 -18] [-178 -16]} true
+	// {[-178 -18] [178 -16]}
+}
+
+func ExampleCutAntimeridian() {
+	flight := orb.LineString{{170, 0}, {-170, 10}}
+
+	fmt.Println(geo.CutAntimeridian(flight))
+	// Output:
+	// [[[170 0] [180 5]] [[-180 5] [-170 10]]]
+}
+
+func ExampleDensify() {
+	// the great circle from new york to beijing goes over the arctic
+	flight := orb.LineString{{-73.8, 40.6}, {116.6, 40.1}}
+
+	route := geo.Densify(flight, 2000000).(orb.LineString)
+	fmt.Printf("%0.1f", route)
+	// Output:
+	// [[-73.8 40.6] [-77.9 56.8] [-88.6 72.7] [-160.9 83.9] [130.9 72.2] [120.7 56.3] [116.6 40.1]]
+}
+
 func ExampleDistance() {
 	oakland := orb.Point{-122.270833, 37.804444}
 	sf := orb.Point{-122.416667, 37.783333}
@@ -35,6 +75,28 @@ func ExampleDistance() {
 	// 13042.047 meters
 }
 
+func ExampleDistanceGeodesic() {
+	jfk := orb.Point{-73.8, 40.6}
+	lhr := orb.Point{-0.5, 51.6}
+
+	fmt.Printf("sphere:    %0.0f meters\n", geo.DistanceHaversine(jfk, lhr))
+	fmt.Printf("ellipsoid: %0.0f meters\n", geo.DistanceGeodesic(jfk, lhr))
+	// Output:
+	// sphere:    5543087 meters
+	// ellipsoid: 5551759 meters
+}
+
+func ExampleDistanceFrom() {
+	road := orb.LineString{{-122.4163, 37.7792}, {-122.4152, 37.7794}}
+	house := orb.Point{-122.4158, 37.7790}
+
+	fmt.Printf("%0.1f meters\n", geo.DistanceFrom(road, house))
+	fmt.Printf("%0.6f", geo.ClosestPoint(road, house))
+	// Output:
+	// 31.6 meters
+	// [-122.415880 37.779276]
+}
+
 func ExampleLength() {
 
 	poly := orb.Polygon{
@@ -0,0 +1,272 @@
+package geo
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// Bound is a geographic bound in lon/lat. Unlike orb.Bound the Min
+// longitude, the west edge, can be larger than the Max longitude, the east
+// edge. The bound then crosses the antimeridian and contains the longitudes
+// from Min to 180 and from -180 to Max. Longitudes are in [-180, 180].
+// An orb.Bound that does not cross the antimeridian can be converted
+// with geo.Bound(b).
+type Bound struct {
+	Min, Max orb.Point
+}
+
+// emptyGeoBound has the latitudes reversed so it is empty.
+var emptyGeoBound = Bound{Min: orb.Point{1, 1}, Max: orb.Point{-1, -1}}
+
+// BoundOf returns the smallest geographic bound that contains all the
+// points of the geometry. For a geometry around the antimeridian, like
+// Fiji, this is the bound across it instead of one that goes all the way
+// around the earth. Returns an empty bound for empty geometries.
+func BoundOf(g orb.Geometry) Bound {
+	if g == nil {
+		return emptyGeoBound
+	}
+
+	var lons []float64
+	b := emptyGeoBound
+	for _, p := range geometryPoints(nil, g) {
+		if b.IsEmpty() {
+			b.Min[1], b.Max[1] = p[1], p[1]
+		}
+		b.Min[1] = math.Min(b.Min[1], p[1])
+		b.Max[1] = math.Max(b.Max[1], p[1])
+
+		lons = append(lons, angNormalize(p[0]))
+	}
+
+	if len(lons) == 0 {
+		return emptyGeoBound
+	}
+
+	// the bound is the complement of the largest gap between longitudes,
+	// which is the one across the antimeridian unless another is larger.
+	sort.Float64s(lons)
+
+	b.Min[0], b.Max[0] = lons[0], lons[len(lons)-1]
+	gap := lons[0] + 360 - lons[len(lons)-1]
+	for i := 1; i < len(lons); i++ {
+		if d := lons[i] - lons[i-1]; d > gap {
+			gap = d
+			b.Min[0], b.Max[0] = lons[i], lons[i-1]
+		}
+	}
+
+	return b.normalize()
+}
+
+func geometryPoints(points []orb.Point, g orb.Geometry) []orb.Point {
+	switch g := g.(type) {
+	case orb.Point:
+		return append(points, g)
+	case orb.MultiPoint:
+		return append(points, g...)
+	case orb.LineString:
+		return append(points, g...)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			points = append(points, ls...)
+		}
+		return points
+	case orb.Ring:
+		return append(points, g...)
+	case orb.Polygon:
+		for _, r := range g {
+			points = append(points, r...)
+		}
+		return points
+	case orb.MultiPolygon:
+		for _, p := range g {
+			for _, r := range p {
+				points = append(points, r...)
+			}
+		}
+		return points
+	case orb.Collection:
+		for _, c := range g {
+			points = geometryPoints(points, c)
+		}
+		return points
+	case orb.Bound:
+		if g.IsEmpty() {
+			return points
+		}
+		return append(points, g.Min, g.Max)
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return geometryPoints(points, zm.ToXY(g))
+	}
+
+	return points
+}
+
+// IsEmpty returns true if the bound does not contain any points,
+// i.e. the Min latitude is larger than the Max latitude.
+func (b Bound) IsEmpty() bool {
+	return b.Min[1] > b.Max[1]
+}
+
+// CrossesAntimeridian returns true if the bound contains longitudes
+// on both sides of the antimeridian.
+func (b Bound) CrossesAntimeridian() bool {
+	return b.Min[0] > b.Max[0]
+}
+
+// Width returns the size of the bound in degrees of longitude.
+func (b Bound) Width() float64 {
+	return lonWidth(b.Min[0], b.Max[0])
+}
+
+// Bounds returns the bound as one orb.Bound, or two if it crosses the
+// antimeridian: the part from Min to 180 and the part from -180 to Max.
+func (b Bound) Bounds() []orb.Bound {
+	if b.IsEmpty() {
+		return nil
+	}
+
+	if !b.CrossesAntimeridian() {
+		return []orb.Bound{{Min: b.Min, Max: b.Max}}
+	}
+
+	return []orb.Bound{
+		{Min: b.Min, Max: orb.Point{180, b.Max[1]}},
+		{Min: orb.Point{-180, b.Min[1]}, Max: b.Max},
+	}
+}
+
+// Contains returns true if the point is inside the bound or on its edges.
+func (b Bound) Contains(p orb.Point) bool {
+	if b.IsEmpty() || p[1] < b.Min[1] || p[1] > b.Max[1] {
+		return false
+	}
+
+	lon := angNormalize(p[0])
+	return b.containsLon(lon) || (lon == 180 && b.containsLon(-180))
+}
+
+func (b Bound) containsLon(lon float64) bool {
+	if b.CrossesAntimeridian() {
+		return lon >= b.Min[0] || lon <= b.Max[0]
+	}
+
+	return b.Min[0] <= lon && lon <= b.Max[0]
+}
+
+// Intersects returns true if the bounds share any points,
+// including touching edges.
+func (b Bound) Intersects(c Bound) bool {
+	if b.IsEmpty() || c.IsEmpty() {
+		return false
+	}
+
+	if b.Max[1] < c.Min[1] || c.Max[1] < b.Min[1] {
+		return false
+	}
+
+	return b.containsLon(c.Min[0]) || c.containsLon(b.Min[0])
+}
+
+// Union returns the smallest bound that contains both bounds. If the bounds
+// do not overlap, the result goes the shorter way around the earth, which
+// may cross the antimeridian.
+func (b Bound) Union(c Bound) Bound {
+	if c.IsEmpty() {
+		return b
+	}
+
+	if b.IsEmpty() {
+		return c
+	}
+
+	result := Bound{
+		Min: orb.Point{0, math.Min(b.Min[1], c.Min[1])},
+		Max: orb.Point{0, math.Max(b.Max[1], c.Max[1])},
+	}
+
+	bw, cw := b.Width(), c.Width()
+	switch {
+	case bw >= 360 || lonWidth(b.Min[0], c.Min[0])+cw <= bw:
+		// b contains c
+		result.Min[0], result.Max[0] = b.Min[0], b.Max[0]
+	case cw >= 360 || lonWidth(c.Min[0], b.Min[0])+bw <= cw:
+		// c contains b
+		result.Min[0], result.Max[0] = c.Min[0], c.Max[0]
+	case b.containsLon(c.Min[0]) && c.containsLon(b.Min[0]):
+		// they overlap at both ends and cover all longitudes
+		result.Min[0], result.Max[0] = -180, 180
+	case b.containsLon(c.Min[0]):
+		result.Min[0], result.Max[0] = b.Min[0], c.Max[0]
+	case c.containsLon(b.Min[0]):
+		result.Min[0], result.Max[0] = c.Min[0], b.Max[0]
+	case lonWidth(b.Min[0], c.Max[0]) <= lonWidth(c.Min[0], b.Max[0]):
+		result.Min[0], result.Max[0] = b.Min[0], c.Max[0]
+	default:
+		result.Min[0], result.Max[0] = c.Min[0], b.Max[0]
+	}
+
+	return result.normalize()
+}
+
+// Extend grows the bound to include the point.
+func (b Bound) Extend(p orb.Point) Bound {
+	return b.Union(Bound{Min: p, Max: p})
+}
+
+// Pad expands the bound in all directions by the given amount of meters.
+// Unlike BoundPad the longitudes wrap around the antimeridian.
+func (b Bound) Pad(meters float64) Bound {
+	if b.IsEmpty() {
+		return b
+	}
+
+	dy := meters / 111131.75
+	dx := dy / math.Cos(deg2rad(b.Max[1]))
+	dx = math.Max(dx, dy/math.Cos(deg2rad(b.Min[1])))
+
+	b.Min[1] = math.Max(b.Min[1]-dy, -90)
+	b.Max[1] = math.Min(b.Max[1]+dy, 90)
+
+	if b.Width()+2*dx >= 360 || math.IsNaN(dx) {
+		b.Min[0], b.Max[0] = -180, 180
+		return b
+	}
+
+	b.Min[0] = angNormalize(b.Min[0] - dx)
+	b.Max[0] = angNormalize(b.Max[0] + dx)
+
+	return b.normalize()
+}
+
+// normalize makes a bound that only touches the antimeridian not cross it.
+func (b Bound) normalize() Bound {
+	if !b.CrossesAntimeridian() {
+		return b
+	}
+
+	if b.Min[0] == 180 {
+		b.Min[0] = -180
+	}
+
+	if b.Max[0] == -180 {
+		b.Max[0] = 180
+	}
+
+	return b
+}
+
+// lonWidth returns the degrees going east from the west to the east longitude.
+func lonWidth(west, east float64) float64 {
+	if east >= west {
+		return east - west
+	}
+
+	return east - west + 360
+}
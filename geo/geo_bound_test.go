@@ -0,0 +1,247 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestBoundOf(t *testing.T) {
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		expected Bound
+	}{
+		{
+			name:     "point",
+			geom:     orb.Point{1, 2},
+			expected: Bound{Min: orb.Point{1, 2}, Max: orb.Point{1, 2}},
+		},
+		{
+			name:     "does not cross",
+			geom:     orb.LineString{{-10, 5}, {20, -5}, {0, 0}},
+			expected: Bound{Min: orb.Point{-10, -5}, Max: orb.Point{20, 5}},
+		},
+		{
+			name:     "fiji",
+			geom:     orb.Polygon{{{178, -16}, {-178, -16}, {-178, -18}, {178, -18}, {178, -16}}},
+			expected: Bound{Min: orb.Point{178, -18}, Max: orb.Point{-178, -16}},
+		},
+		{
+			name:     "touches the antimeridian",
+			geom:     orb.MultiPoint{{-180, 0}, {-170, 1}},
+			expected: Bound{Min: orb.Point{-180, 0}, Max: orb.Point{-170, 1}},
+		},
+		{
+			name: "collection",
+			geom: orb.Collection{
+				orb.Point{170, 0},
+				orb.Bound{Min: orb.Point{-175, 10}, Max: orb.Point{-170, 20}},
+			},
+			expected: Bound{Min: orb.Point{170, 0}, Max: orb.Point{-170, 20}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			b := BoundOf(tc.geom)
+			if b != tc.expected {
+				t.Errorf("incorrect bound: %v != %v", b, tc.expected)
+			}
+		})
+	}
+
+	if b := BoundOf(orb.LineString{}); !b.IsEmpty() {
+		t.Errorf("should be empty: %v", b)
+	}
+}
+
+func TestBound_Contains(t *testing.T) {
+	b := Bound{Min: orb.Point{170, -10}, Max: orb.Point{-170, 10}}
+
+	cases := []struct {
+		name   string
+		point  orb.Point
+		result bool
+	}{
+		{name: "west side", point: orb.Point{175, 0}, result: true},
+		{name: "east side", point: orb.Point{-175, 0}, result: true},
+		{name: "on 180", point: orb.Point{180, 0}, result: true},
+		{name: "on -180", point: orb.Point{-180, 0}, result: true},
+		{name: "on the edge", point: orb.Point{170, 10}, result: true},
+		{name: "the other way around", point: orb.Point{0, 0}, result: false},
+		{name: "above", point: orb.Point{175, 11}, result: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := b.Contains(tc.point); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+		})
+	}
+
+	edge := Bound{Min: orb.Point{-180, 0}, Max: orb.Point{-170, 10}}
+	if !edge.Contains(orb.Point{180, 5}) {
+		t.Errorf("180 should be the same as -180")
+	}
+}
+
+func TestBound_Intersects(t *testing.T) {
+	b := Bound{Min: orb.Point{170, -10}, Max: orb.Point{-170, 10}}
+
+	cases := []struct {
+		name   string
+		bound  Bound
+		result bool
+	}{
+		{
+			name:   "west side",
+			bound:  Bound{Min: orb.Point{160, 0}, Max: orb.Point{175, 20}},
+			result: true,
+		},
+		{
+			name:   "east side",
+			bound:  Bound{Min: orb.Point{-175, 0}, Max: orb.Point{-160, 20}},
+			result: true,
+		},
+		{
+			name:   "both cross",
+			bound:  Bound{Min: orb.Point{179, 0}, Max: orb.Point{-179, 1}},
+			result: true,
+		},
+		{
+			name:   "the other way around",
+			bound:  Bound{Min: orb.Point{-160, 0}, Max: orb.Point{160, 1}},
+			result: false,
+		},
+		{
+			name:   "above",
+			bound:  Bound{Min: orb.Point{175, 11}, Max: orb.Point{176, 12}},
+			result: false,
+		},
+		{
+			name:   "empty",
+			bound:  emptyGeoBound,
+			result: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := b.Intersects(tc.bound); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+
+			if v := tc.bound.Intersects(b); v != tc.result {
+				t.Errorf("incorrect reverse result: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestBound_Union(t *testing.T) {
+	cases := []struct {
+		name     string
+		b1, b2   Bound
+		expected Bound
+	}{
+		{
+			name:     "shorter way across the antimeridian",
+			b1:       Bound{Min: orb.Point{170, 0}, Max: orb.Point{175, 1}},
+			b2:       Bound{Min: orb.Point{-175, 2}, Max: orb.Point{-170, 3}},
+			expected: Bound{Min: orb.Point{170, 0}, Max: orb.Point{-170, 3}},
+		},
+		{
+			name:     "shorter way not across",
+			b1:       Bound{Min: orb.Point{-10, 0}, Max: orb.Point{-5, 1}},
+			b2:       Bound{Min: orb.Point{5, 0}, Max: orb.Point{10, 1}},
+			expected: Bound{Min: orb.Point{-10, 0}, Max: orb.Point{10, 1}},
+		},
+		{
+			name:     "contains",
+			b1:       Bound{Min: orb.Point{170, 0}, Max: orb.Point{-170, 10}},
+			b2:       Bound{Min: orb.Point{-175, 2}, Max: orb.Point{-172, 3}},
+			expected: Bound{Min: orb.Point{170, 0}, Max: orb.Point{-170, 10}},
+		},
+		{
+			name:     "overlap",
+			b1:       Bound{Min: orb.Point{170, 0}, Max: orb.Point{-170, 10}},
+			b2:       Bound{Min: orb.Point{-175, 2}, Max: orb.Point{-160, 3}},
+			expected: Bound{Min: orb.Point{170, 0}, Max: orb.Point{-160, 10}},
+		},
+		{
+			name:     "covers all longitudes",
+			b1:       Bound{Min: orb.Point{90, 0}, Max: orb.Point{-90, 10}},
+			b2:       Bound{Min: orb.Point{-100, 0}, Max: orb.Point{100, 10}},
+			expected: Bound{Min: orb.Point{-180, 0}, Max: orb.Point{180, 10}},
+		},
+		{
+			name:     "empty",
+			b1:       Bound{Min: orb.Point{170, 0}, Max: orb.Point{-170, 10}},
+			b2:       emptyGeoBound,
+			expected: Bound{Min: orb.Point{170, 0}, Max: orb.Point{-170, 10}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := tc.b1.Union(tc.b2); v != tc.expected {
+				t.Errorf("incorrect union: %v != %v", v, tc.expected)
+			}
+
+			if v := tc.b2.Union(tc.b1); v != tc.expected {
+				t.Errorf("incorrect reverse union: %v != %v", v, tc.expected)
+			}
+		})
+	}
+}
+
+func TestBound_Extend(t *testing.T) {
+	b := Bound{Min: orb.Point{170, 0}, Max: orb.Point{175, 1}}
+
+	b = b.Extend(orb.Point{-178, 2})
+	expected := Bound{Min: orb.Point{170, 0}, Max: orb.Point{-178, 2}}
+	if b != expected {
+		t.Errorf("incorrect bound: %v != %v", b, expected)
+	}
+}
+
+func TestBound_Bounds(t *testing.T) {
+	b := Bound{Min: orb.Point{170, 0}, Max: orb.Point{-170, 10}}
+
+	bounds := b.Bounds()
+	if len(bounds) != 2 {
+		t.Fatalf("should be split in 2: %v", bounds)
+	}
+
+	if bounds[0].Min[0] != 170 || bounds[0].Max[0] != 180 {
+		t.Errorf("incorrect west part: %v", bounds[0])
+	}
+
+	if bounds[1].Min[0] != -180 || bounds[1].Max[0] != -170 {
+		t.Errorf("incorrect east part: %v", bounds[1])
+	}
+
+	if v := b.Width(); v != 20 {
+		t.Errorf("incorrect width: %v", v)
+	}
+}
+
+func TestBound_Pad(t *testing.T) {
+	b := Bound{Min: orb.Point{179, 0}, Max: orb.Point{179.5, 1}}
+
+	b = b.Pad(200000)
+	if !b.CrossesAntimeridian() {
+		t.Errorf("should wrap around the antimeridian: %v", b)
+	}
+
+	if b.Min[1] >= 0 || b.Max[1] <= 1 {
+		t.Errorf("latitude should be padded: %v", b)
+	}
+
+	full := Bound{Min: orb.Point{-170, 0}, Max: orb.Point{170, 1}}.Pad(2000000)
+	if full.Min[0] != -180 || full.Max[0] != 180 {
+		t.Errorf("should cover all longitudes: %v", full)
+	}
+}
@@ -0,0 +1,705 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/length"
+)
+
+// WGS84Flattening is the flattening of the WGS84 ellipsoid used by the
+// geodesic functions. The semi-major axis is orb.EarthRadius.
+const WGS84Flattening = 1 / 298.257223563
+
+// wgs84 is the ellipsoid used by the geodesic functions.
+var wgs84 = newEllipsoid(orb.EarthRadius, WGS84Flattening)
+
+// GeodesicInverse returns the distance in meters along the shortest path
+// on the WGS84 ellipsoid between the points, and the bearings in degrees
+// clockwise from north at the start and end of the path. It uses Karney's
+// algorithm which is accurate to about 15 nanometers and, unlike Vincenty's
+// formulae, converges for nearly antipodal points.
+func GeodesicInverse(p1, p2 orb.Point) (distance, bearing1, bearing2 float64) {
+	r := wgs84.inverse(p1[1], p1[0], p2[1], p2[0], false)
+	return r.s12, atan2d(r.salp1, r.calp1), atan2d(r.salp2, r.calp2)
+}
+
+// DistanceGeodesic returns the distance in meters along the shortest path
+// between the two points on the WGS84 ellipsoid. It is slower but more
+// accurate than DistanceHaversine which assumes a sphere.
+func DistanceGeodesic(p1, p2 orb.Point) float64 {
+	return wgs84.inverse(p1[1], p1[0], p2[1], p2[0], false).s12
+}
+
+// LengthGeodesic returns the length of the boundary of the geometry
+// using the distance on the WGS84 ellipsoid.
+func LengthGeodesic(g orb.Geometry) float64 {
+	return length.Length(g, DistanceGeodesic)
+}
+
+// GeodesicDirect returns the point at the distance in meters along the
+// geodesic starting at the point with the bearing, in degrees clockwise
+// from north, on the WGS84 ellipsoid. The bearing at the end point is also
+// returned. The longitude of the result is in the range [-180, 180].
+func GeodesicDirect(p orb.Point, bearing, distance float64) (orb.Point, float64) {
+	l := wgs84.line(p[1], p[0], bearing)
+	lat, lon, azi := l.position(distance)
+	return orb.Point{lon, lat}, azi
+}
+
+// PointAtBearingAndDistanceGeodesic returns the point at the given bearing
+// and distance in meters from the point on the WGS84 ellipsoid.
+func PointAtBearingAndDistanceGeodesic(p orb.Point, bearing, distance float64) orb.Point {
+	r, _ := GeodesicDirect(p, bearing, distance)
+	return r
+}
+
+// ellipsoid holds the constants needed to solve geodesic problems.
+type ellipsoid struct {
+	a, f   float64 // semi-major axis and flattening
+	f1, b  float64 // 1 - f and the semi-minor axis
+	e2     float64 // eccentricity squared
+	ep2    float64 // second eccentricity squared
+	n      float64 // third flattening
+	etol2  float64
+	c2     float64 // authalic radius squared
+	a3x    []float64
+	c3x    []float64
+	c4x    []float64
+	maxit1 int
+	maxit2 int
+}
+
+func newEllipsoid(a, f float64) *ellipsoid {
+	e := &ellipsoid{a: a, f: f}
+	e.f1 = 1 - f
+	e.e2 = f * (2 - f)
+	e.ep2 = e.e2 / (e.f1 * e.f1)
+	e.n = f / (2 - f)
+	e.b = a * e.f1
+	e.etol2 = 0.1 * tol2 / math.Sqrt(math.Max(0.001, math.Abs(f))*math.Min(1, 1-f/2)/2)
+
+	e.a3x = a3Coefficients(e.n)
+	e.c3x = c3Coefficients(e.n)
+	e.c4x = c4Coefficients(e.n)
+
+	// the authalic radius squared
+	e.c2 = (a*a + e.b*e.b*math.Atanh(math.Sqrt(e.e2))/math.Sqrt(e.e2)) / 2
+
+	e.maxit1 = 20
+	e.maxit2 = e.maxit1 + 53 + 10
+
+	return e
+}
+
+func (e *ellipsoid) a3(eps float64) float64 {
+	return polyval(geodesicOrder-1, e.a3x, 0, eps)
+}
+
+func (e *ellipsoid) c3(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 1; l < geodesicOrder; l++ {
+		m := geodesicOrder - l - 1
+		mult *= eps
+		c[l] = mult * polyval(m, e.c3x, o, eps)
+		o += m + 1
+	}
+}
+
+func (e *ellipsoid) c4(eps float64, c []float64) {
+	mult := 1.0
+	o := 0
+	for l := 0; l < geodesicOrder; l++ {
+		m := geodesicOrder - l - 1
+		c[l] = mult * polyval(m, e.c4x, o, eps)
+		o += m + 1
+		mult *= eps
+	}
+}
+
+// lengths returns the distance and reduced length, scaled by b, of the
+// part of the geodesic between the two points given as reduced latitudes
+// and arc lengths on the auxiliary sphere. Also returns m0.
+func (e *ellipsoid) lengths(
+	eps, sig12,
+	ssig1, csig1, dn1,
+	ssig2, csig2, dn2 float64,
+	c1a, c2a []float64,
+) (float64, float64, float64) {
+	a1 := a1m1(eps)
+	c1(eps, c1a)
+	a2 := a2m1(eps)
+	c2(eps, c2a)
+
+	m0 := a1 - a2
+	a1++
+	a2++
+
+	b1 := sinCosSeries(true, ssig2, csig2, c1a) - sinCosSeries(true, ssig1, csig1, c1a)
+	b2 := sinCosSeries(true, ssig2, csig2, c2a) - sinCosSeries(true, ssig1, csig1, c2a)
+
+	s12b := a1 * (sig12 + b1)
+	j12 := m0*sig12 + (a1*b1 - a2*b2)
+	m12b := dn2*(csig1*ssig2) - dn1*(ssig1*csig2) - csig1*csig2*j12
+
+	return s12b, m12b, m0
+}
+
+// inverseStart returns a starting guess for the azimuth at the first point.
+// If the points are close together sig12 is set, it is negative otherwise.
+func (e *ellipsoid) inverseStart(
+	sbet1, cbet1, dn1,
+	sbet2, cbet2, dn2,
+	lam12, slam12, clam12 float64,
+) (sig12, salp1, calp1, salp2, calp2, dnm float64) {
+	sig12 = -1
+
+	sbet12 := sbet2*cbet1 - cbet2*sbet1
+	cbet12 := cbet2*cbet1 + sbet2*sbet1
+	sbet12a := sbet2*cbet1 + cbet2*sbet1
+
+	shortline := cbet12 >= 0 && sbet12 < 0.5 && cbet2*lam12 < 0.5
+
+	var somg12, comg12 float64
+	if shortline {
+		sbetm2 := (sbet1 + sbet2) * (sbet1 + sbet2)
+		sbetm2 /= sbetm2 + (cbet1+cbet2)*(cbet1+cbet2)
+		dnm = math.Sqrt(1 + e.ep2*sbetm2)
+		omg12 := lam12 / (e.f1 * dnm)
+		somg12, comg12 = math.Sincos(omg12)
+	} else {
+		somg12, comg12 = slam12, clam12
+	}
+
+	salp1 = cbet2 * somg12
+	if comg12 >= 0 {
+		calp1 = sbet12 + cbet2*sbet1*somg12*somg12/(1+comg12)
+	} else {
+		calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+	}
+
+	ssig12 := math.Hypot(salp1, calp1)
+	csig12 := sbet1*sbet2 + cbet1*cbet2*comg12
+
+	if shortline && ssig12 < e.etol2 {
+		// really short lines
+		salp2 = cbet1 * somg12
+		if comg12 >= 0 {
+			calp2 = sbet12 - cbet1*sbet2*(somg12*somg12/(1+comg12))
+		} else {
+			calp2 = sbet12 - cbet1*sbet2*(1-comg12)
+		}
+		salp2, calp2 = norm(salp2, calp2)
+		sig12 = math.Atan2(ssig12, csig12)
+	} else if math.Abs(e.n) >= 0.1 || csig12 >= 0 ||
+		ssig12 >= 6*math.Abs(e.n)*math.Pi*cbet1*cbet1 {
+		// nothing to do, the zeroth order spherical approximation is fine
+	} else {
+		// nearly antipodal points, scale to the astroid problem
+		lam12x := math.Atan2(-slam12, -clam12)
+
+		k2 := sbet1 * sbet1 * e.ep2
+		eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+		lamscale := e.f * cbet1 * e.a3(eps) * math.Pi
+		betscale := lamscale * cbet1
+
+		x := lam12x / lamscale
+		y := sbet12a / betscale
+
+		if y > -tol1 && x > -1-xthresh {
+			salp1 = math.Min(1, -x)
+			calp1 = -math.Sqrt(1 - salp1*salp1)
+		} else {
+			k := astroid(x, y)
+			omg12a := lamscale * (-x * k / (1 + k))
+			somg12, comg12 = math.Sincos(omg12a)
+			comg12 = -comg12
+
+			salp1 = cbet2 * somg12
+			calp1 = sbet12a - cbet2*sbet1*somg12*somg12/(1-comg12)
+		}
+	}
+
+	if salp1 > 0 {
+		salp1, calp1 = norm(salp1, calp1)
+	} else {
+		salp1, calp1 = 1, 0
+	}
+
+	return sig12, salp1, calp1, salp2, calp2, dnm
+}
+
+// lambda12 is the result of solving the hybrid problem, the longitude
+// difference for the azimuth at the first point.
+type lambda12 struct {
+	lam12        float64
+	salp2, calp2 float64
+	sig12        float64
+	ssig1, csig1 float64
+	ssig2, csig2 float64
+	eps, domg12  float64
+	dlam12       float64
+}
+
+func (e *ellipsoid) lambda12(
+	sbet1, cbet1, dn1,
+	sbet2, cbet2, dn2,
+	salp1, calp1,
+	slam120, clam120 float64,
+	diffp bool,
+	c1a, c2a, c3a []float64,
+) lambda12 {
+	var r lambda12
+
+	if sbet1 == 0 && calp1 == 0 {
+		// break the degeneracy of equatorial lines
+		calp1 = -tiny
+	}
+
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+
+	somg1 := salp0 * sbet1
+	comg1 := calp1 * cbet1
+	r.ssig1, r.csig1 = norm(sbet1, comg1)
+
+	if cbet2 != cbet1 {
+		r.salp2 = salp0 / cbet2
+	} else {
+		r.salp2 = salp1
+	}
+
+	if cbet2 != cbet1 || math.Abs(sbet2) != -sbet1 {
+		var t float64
+		if cbet1 < -sbet1 {
+			t = (cbet2 - cbet1) * (cbet1 + cbet2)
+		} else {
+			t = (sbet1 - sbet2) * (sbet1 + sbet2)
+		}
+		r.calp2 = math.Sqrt((calp1*cbet1)*(calp1*cbet1)+t) / cbet2
+	} else {
+		r.calp2 = math.Abs(calp1)
+	}
+
+	somg2 := salp0 * sbet2
+	comg2 := r.calp2 * cbet2
+	r.ssig2, r.csig2 = norm(sbet2, comg2)
+
+	r.sig12 = math.Atan2(
+		math.Max(0, r.csig1*r.ssig2-r.ssig1*r.csig2),
+		r.csig1*r.csig2+r.ssig1*r.ssig2,
+	)
+
+	somg12 := math.Max(0, comg1*somg2-somg1*comg2)
+	comg12 := comg1*comg2 + somg1*somg2
+	eta := math.Atan2(somg12*clam120-comg12*slam120, comg12*clam120+somg12*slam120)
+
+	k2 := calp0 * calp0 * e.ep2
+	r.eps = k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+
+	e.c3(r.eps, c3a)
+	b312 := sinCosSeries(true, r.ssig2, r.csig2, c3a) - sinCosSeries(true, r.ssig1, r.csig1, c3a)
+	r.domg12 = -e.f * e.a3(r.eps) * salp0 * (r.sig12 + b312)
+	r.lam12 = eta + r.domg12
+
+	if diffp {
+		if r.calp2 == 0 {
+			r.dlam12 = -2 * e.f1 * dn1 / sbet1
+		} else {
+			_, m12b, _ := e.lengths(r.eps, r.sig12, r.ssig1, r.csig1, dn1, r.ssig2, r.csig2, dn2, c1a, c2a)
+			r.dlam12 = m12b * e.f1 / (r.calp2 * cbet2)
+		}
+	} else {
+		r.dlam12 = math.NaN()
+	}
+
+	return r
+}
+
+// inverseResult is the solution of the inverse problem. The azimuths are
+// given as the sine and cosine.
+type inverseResult struct {
+	s12          float64
+	salp1, calp1 float64
+	salp2, calp2 float64
+
+	// area is the area between the geodesic and the equator,
+	// only computed if requested.
+	area float64
+}
+
+// inverse solves the inverse geodesic problem, finding the shortest path
+// between two points. The area between the path and the equator is also
+// computed if requested.
+func (e *ellipsoid) inverse(lat1, lon1, lat2, lon2 float64, area bool) inverseResult {
+	// make lat1 <= 0 and |lat1| >= |lat2| and 0 <= lon12 <= 180,
+	// the signs are used to undo the transformation at the end.
+	lon12, lon12s := angDiff(lon1, lon2)
+	lonsign := 1.0
+	if math.Signbit(lon12) {
+		lonsign = -1
+	}
+
+	lon12 = lonsign * angRound(lon12)
+	lon12s = angRound((180 - lon12) - lonsign*lon12s)
+	lam12 := deg2rad(lon12)
+
+	var slam12, clam12 float64
+	if lon12 > 90 {
+		slam12, clam12 = sincosd(lon12s)
+		clam12 = -clam12
+	} else {
+		slam12, clam12 = sincosd(lon12)
+	}
+
+	lat1 = angRound(latFix(lat1))
+	lat2 = angRound(latFix(lat2))
+
+	swapp := 1.0
+	if math.Abs(lat1) < math.Abs(lat2) {
+		swapp = -1
+		lonsign *= -1
+		lat1, lat2 = lat2, lat1
+	}
+
+	latsign := -1.0
+	if lat1 < 0 {
+		latsign = 1
+	}
+	lat1 *= latsign
+	lat2 *= latsign
+
+	sbet1, cbet1 := sincosd(lat1)
+	sbet1, cbet1 = norm(e.f1*sbet1, cbet1)
+	cbet1 = math.Max(tiny, cbet1)
+
+	sbet2, cbet2 := sincosd(lat2)
+	sbet2, cbet2 = norm(e.f1*sbet2, cbet2)
+	cbet2 = math.Max(tiny, cbet2)
+
+	// make the rounding errors symmetric
+	if cbet1 < -sbet1 {
+		if cbet2 == cbet1 {
+			sbet2 = math.Copysign(sbet1, sbet2)
+		}
+	} else if math.Abs(sbet2) == -sbet1 {
+		cbet2 = cbet1
+	}
+
+	dn1 := math.Sqrt(1 + e.ep2*sbet1*sbet1)
+	dn2 := math.Sqrt(1 + e.ep2*sbet2*sbet2)
+
+	c1a := make([]float64, geodesicOrder+1)
+	c2a := make([]float64, geodesicOrder+1)
+	c3a := make([]float64, geodesicOrder)
+
+	var (
+		s12x                       float64
+		salp1, calp1, salp2, calp2 float64
+	)
+
+	// the longitude difference on the auxiliary sphere, needed for the area
+	var omg12 float64
+	somg12, comg12 := 2.0, 0.0 // somg12 == 2 means it is not set
+
+	meridian := lat1 == -90 || slam12 == 0
+	if meridian {
+		// the path is along a meridian, or through a pole
+		salp1, calp1 = slam12, clam12
+		salp2, calp2 = 0, 1
+
+		ssig1, csig1 := sbet1, calp1*cbet1
+		ssig2, csig2 := sbet2, calp2*cbet2
+
+		sig12 := math.Atan2(
+			math.Max(0, csig1*ssig2-ssig1*csig2),
+			csig1*csig2+ssig1*ssig2,
+		)
+
+		s12b, m12b, _ := e.lengths(e.n, sig12, ssig1, csig1, dn1, ssig2, csig2, dn2, c1a, c2a)
+		if sig12 < 1 || m12b >= 0 {
+			if sig12 < 3*tiny || (sig12 < tol0 && (s12b < 0 || m12b < 0)) {
+				s12b = 0
+			}
+			s12x = s12b * e.b
+		} else {
+			// the meridian is not the shortest path for nearly antipodal points
+			meridian = false
+		}
+	}
+
+	if !meridian && sbet1 == 0 && (e.f <= 0 || lon12s >= e.f*180) {
+		// along the equator
+		salp1, calp1 = 1, 0
+		salp2, calp2 = 1, 0
+		s12x = e.a * lam12
+		omg12 = lam12 / e.f1
+	} else if !meridian {
+		var (
+			sig12 float64
+			dnm   float64
+		)
+
+		sig12, salp1, calp1, salp2, calp2, dnm = e.inverseStart(
+			sbet1, cbet1, dn1,
+			sbet2, cbet2, dn2,
+			lam12, slam12, clam12,
+		)
+
+		if sig12 >= 0 {
+			// short lines, the starting guess is good enough
+			s12x = sig12 * e.b * dnm
+			omg12 = lam12 / (e.f1 * dnm)
+		} else {
+			// Newton's method on the longitude difference, falling back to
+			// bisection if that does not converge.
+			var r lambda12
+			tripn, tripb := false, false
+			salp1a, calp1a := tiny, 1.0
+			salp1b, calp1b := tiny, -1.0
+
+			for numit := 0; numit < e.maxit2; numit++ {
+				r = e.lambda12(
+					sbet1, cbet1, dn1,
+					sbet2, cbet2, dn2,
+					salp1, calp1,
+					slam12, clam12,
+					numit < e.maxit1,
+					c1a, c2a, c3a,
+				)
+				v := r.lam12
+
+				bound := tol0
+				if tripn {
+					bound *= 8
+				}
+
+				if tripb || !(math.Abs(v) >= bound) {
+					break
+				}
+
+				// update the bracket
+				if v > 0 && (numit > e.maxit1 || calp1/salp1 > calp1b/salp1b) {
+					salp1b, calp1b = salp1, calp1
+				} else if v < 0 && (numit > e.maxit1 || calp1/salp1 < calp1a/salp1a) {
+					salp1a, calp1a = salp1, calp1
+				}
+
+				if numit < e.maxit1 && r.dlam12 > 0 {
+					dalp1 := -v / r.dlam12
+					if math.Abs(dalp1) < math.Pi {
+						sdalp1, cdalp1 := math.Sincos(dalp1)
+						nsalp1 := salp1*cdalp1 + calp1*sdalp1
+						if nsalp1 > 0 {
+							calp1 = calp1*cdalp1 - salp1*sdalp1
+							salp1, calp1 = norm(nsalp1, calp1)
+							tripn = math.Abs(v) <= 16*tol0
+							continue
+						}
+					}
+				}
+
+				salp1, calp1 = norm((salp1a+salp1b)/2, (calp1a+calp1b)/2)
+				tripn = false
+				tripb = math.Abs(salp1a-salp1)+(calp1a-calp1) < tolb ||
+					math.Abs(salp1-salp1b)+(calp1-calp1b) < tolb
+			}
+
+			salp2, calp2 = r.salp2, r.calp2
+			s12b, _, _ := e.lengths(r.eps, r.sig12, r.ssig1, r.csig1, dn1, r.ssig2, r.csig2, dn2, c1a, c2a)
+			s12x = s12b * e.b
+
+			sdomg12, cdomg12 := math.Sincos(r.domg12)
+			somg12 = slam12*cdomg12 - clam12*sdomg12
+			comg12 = clam12*cdomg12 + slam12*sdomg12
+		}
+	}
+
+	var s12Area float64
+	if area {
+		if somg12 == 2 {
+			somg12, comg12 = math.Sincos(omg12)
+		}
+
+		s12Area = e.area(
+			sbet1, cbet1, sbet2, cbet2,
+			salp1, calp1, salp2, calp2,
+			somg12, comg12, meridian,
+		)
+		s12Area *= swapp * lonsign * latsign
+	}
+
+	if swapp < 0 {
+		salp1, salp2 = salp2, salp1
+		calp1, calp2 = calp2, calp1
+	}
+
+	return inverseResult{
+		s12:   0 + s12x,
+		salp1: salp1 * swapp * lonsign,
+		calp1: calp1 * swapp * latsign,
+		salp2: salp2 * swapp * lonsign,
+		calp2: calp2 * swapp * latsign,
+		area:  0 + s12Area,
+	}
+}
+
+// area returns the area between the geodesic and the equator for the
+// inverse problem after the points have been moved into the canonical
+// position.
+func (e *ellipsoid) area(
+	sbet1, cbet1, sbet2, cbet2,
+	salp1, calp1, salp2, calp2,
+	somg12, comg12 float64,
+	meridian bool,
+) float64 {
+	var s12 float64
+
+	salp0 := salp1 * cbet1
+	calp0 := math.Hypot(calp1, salp1*sbet1)
+	if calp0 != 0 && salp0 != 0 {
+		ssig1, csig1 := norm(sbet1, calp1*cbet1)
+		ssig2, csig2 := norm(sbet2, calp2*cbet2)
+
+		k2 := calp0 * calp0 * e.ep2
+		eps := k2 / (2*(1+math.Sqrt(1+k2)) + k2)
+		a4 := e.a * e.a * calp0 * salp0 * e.e2
+
+		c4a := make([]float64, geodesicOrder)
+		e.c4(eps, c4a)
+
+		b41 := sinCosSeries(false, ssig1, csig1, c4a)
+		b42 := sinCosSeries(false, ssig2, csig2, c4a)
+		s12 = a4 * (b42 - b41)
+	}
+
+	var alp12 float64
+	if !meridian && comg12 > -0.7071 && sbet2-sbet1 < 1.75 {
+		// the longitude and latitude differences are not too big, use
+		// tan(gamma/2) = tan(omg12/2) * (tan(bet1/2) + tan(bet2/2)) /
+		// (1 + tan(bet1/2) * tan(bet2/2))
+		domg12 := 1 + comg12
+		dbet1 := 1 + cbet1
+		dbet2 := 1 + cbet2
+		alp12 = 2 * math.Atan2(
+			somg12*(sbet1*dbet2+sbet2*dbet1),
+			domg12*(sbet1*sbet2+dbet1*dbet2),
+		)
+	} else {
+		salp12 := salp2*calp1 - calp2*salp1
+		calp12 := calp2*calp1 + salp2*salp1
+		if salp12 == 0 && calp12 < 0 {
+			// make sure alp1 = 180 and alp2 = 0 gives -180
+			salp12 = tiny * calp1
+			calp12 = -1
+		}
+		alp12 = math.Atan2(salp12, calp12)
+	}
+
+	return s12 + e.c2*alp12
+}
+
+// geodesicLine is a geodesic starting at a point with an azimuth,
+// used to solve the direct problem.
+type geodesicLine struct {
+	e *ellipsoid
+
+	lat1, lon1   float64
+	salp0, calp0 float64
+	ssig1, csig1 float64
+	somg1, comg1 float64
+	stau1, ctau1 float64
+	k2           float64
+
+	a1m1, b11, a3c, b31 float64
+	c1a, c1pa, c3a      []float64
+}
+
+func (e *ellipsoid) line(lat1, lon1, azi1 float64) *geodesicLine {
+	l := &geodesicLine{e: e, lat1: latFix(lat1), lon1: lon1}
+
+	salp1, calp1 := sincosd(angRound(angNormalize(azi1)))
+
+	sbet1, cbet1 := sincosd(angRound(l.lat1))
+	sbet1, cbet1 = norm(e.f1*sbet1, cbet1)
+	cbet1 = math.Max(tiny, cbet1)
+
+	l.salp0 = salp1 * cbet1
+	l.calp0 = math.Hypot(calp1, salp1*sbet1)
+
+	l.somg1 = l.salp0 * sbet1
+	if sbet1 != 0 || calp1 != 0 {
+		l.comg1 = cbet1 * calp1
+	} else {
+		l.comg1 = 1
+	}
+	l.ssig1, l.csig1 = norm(sbet1, l.comg1)
+
+	l.k2 = l.calp0 * l.calp0 * e.ep2
+	eps := l.k2 / (2*(1+math.Sqrt(1+l.k2)) + l.k2)
+
+	l.a1m1 = a1m1(eps)
+	l.c1a = make([]float64, geodesicOrder+1)
+	c1(eps, l.c1a)
+	l.b11 = sinCosSeries(true, l.ssig1, l.csig1, l.c1a)
+
+	s, c := math.Sincos(l.b11)
+	l.stau1 = l.ssig1*c + l.csig1*s
+	l.ctau1 = l.csig1*c - l.ssig1*s
+
+	l.c1pa = make([]float64, geodesicOrder+1)
+	c1p(eps, l.c1pa)
+
+	l.a3c = -e.f * l.salp0 * e.a3(eps)
+	l.c3a = make([]float64, geodesicOrder)
+	e.c3(eps, l.c3a)
+	l.b31 = sinCosSeries(true, l.ssig1, l.csig1, l.c3a)
+
+	return l
+}
+
+// position returns the latitude, longitude and azimuth at the
+// distance along the line.
+func (l *geodesicLine) position(s12 float64) (float64, float64, float64) {
+	e := l.e
+
+	tau12 := s12 / (e.b * (1 + l.a1m1))
+	s, c := math.Sincos(tau12)
+
+	b12 := -sinCosSeries(true, l.stau1*c+l.ctau1*s, l.ctau1*c-l.stau1*s, l.c1pa)
+	sig12 := tau12 - (b12 - l.b11)
+	ssig12, csig12 := math.Sincos(sig12)
+
+	ssig2 := l.ssig1*csig12 + l.csig1*ssig12
+	csig2 := l.csig1*csig12 - l.ssig1*ssig12
+
+	sbet2 := l.calp0 * ssig2
+	cbet2 := math.Hypot(l.salp0, l.calp0*csig2)
+	if cbet2 == 0 {
+		// at a pole
+		cbet2, csig2 = tiny, tiny
+	}
+
+	salp2 := l.salp0
+	calp2 := l.calp0 * csig2
+
+	somg2 := l.salp0 * ssig2
+	comg2 := csig2
+	omg12 := math.Atan2(somg2*l.comg1-comg2*l.somg1, comg2*l.comg1+somg2*l.somg1)
+
+	lam12 := omg12 + l.a3c*(sig12+(sinCosSeries(true, ssig2, csig2, l.c3a)-l.b31))
+	lon2 := angNormalize(angNormalize(l.lon1) + angNormalize(rad2deg(lam12)))
+	lat2 := atan2d(sbet2, e.f1*cbet2)
+
+	return lat2, lon2, atan2d(salp2, calp2)
+}
+
+// latFix returns NaN for latitudes outside [-90, 90].
+func latFix(lat float64) float64 {
+	if math.Abs(lat) > 90 {
+		return math.NaN()
+	}
+
+	return lat
+}
@@ -0,0 +1,231 @@
+package geo
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// AreaGeodesic returns the area of the geometry in square meters on the
+// WGS84 ellipsoid. The edges of the rings are geodesics, the shortest paths
+// between the points, so it is accurate for very large polygons and polygons
+// that contain a pole. The edges of a bound follow the meridians and
+// parallels. Rings are implicitly closed.
+func AreaGeodesic(g orb.Geometry) float64 {
+	if g == nil {
+		return 0
+	}
+
+	switch g := g.(type) {
+	case orb.Point, orb.MultiPoint, orb.LineString, orb.MultiLineString:
+		return 0
+	case orb.Ring:
+		return math.Abs(wgs84.ringArea(g))
+	case orb.Polygon:
+		return wgs84.polygonArea(g)
+	case orb.MultiPolygon:
+		sum := 0.0
+		for _, p := range g {
+			sum += wgs84.polygonArea(p)
+		}
+		return sum
+	case orb.Collection:
+		sum := 0.0
+		for _, c := range g {
+			sum += AreaGeodesic(c)
+		}
+		return sum
+	case orb.Bound:
+		return wgs84.boundArea(g)
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return AreaGeodesic(zm.ToXY(g))
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+// SignedAreaGeodesic returns the signed area of the ring in square meters
+// on the WGS84 ellipsoid. Will return negative if the ring is in the
+// clockwise direction. Of the two parts of the earth bounded by the ring
+// the smaller one is used. Will implicitly close the ring.
+func SignedAreaGeodesic(r orb.Ring) float64 {
+	return wgs84.ringArea(r)
+}
+
+// PerimeterGeodesic returns the length in meters of the rings of the
+// polygons of the geometry on the WGS84 ellipsoid. Points and lines have
+// no perimeter. Rings are implicitly closed.
+func PerimeterGeodesic(g orb.Geometry) float64 {
+	if g == nil {
+		return 0
+	}
+
+	switch g := g.(type) {
+	case orb.Point, orb.MultiPoint, orb.LineString, orb.MultiLineString:
+		return 0
+	case orb.Ring:
+		return ringPerimeterGeodesic(g)
+	case orb.Polygon:
+		sum := 0.0
+		for _, r := range g {
+			sum += ringPerimeterGeodesic(r)
+		}
+		return sum
+	case orb.MultiPolygon:
+		sum := 0.0
+		for _, p := range g {
+			sum += PerimeterGeodesic(p)
+		}
+		return sum
+	case orb.Collection:
+		sum := 0.0
+		for _, c := range g {
+			sum += PerimeterGeodesic(c)
+		}
+		return sum
+	case orb.Bound:
+		return wgs84.boundPerimeter(g)
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return PerimeterGeodesic(zm.ToXY(g))
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+func ringPerimeterGeodesic(r orb.Ring) float64 {
+	if len(r) < 2 {
+		return 0
+	}
+
+	sum := 0.0
+	for i := 1; i < len(r); i++ {
+		sum += DistanceGeodesic(r[i-1], r[i])
+	}
+
+	if r[0] != r[len(r)-1] {
+		sum += DistanceGeodesic(r[len(r)-1], r[0])
+	}
+
+	return sum
+}
+
+func (e *ellipsoid) polygonArea(p orb.Polygon) float64 {
+	if len(p) == 0 {
+		return 0
+	}
+
+	sum := math.Abs(e.ringArea(p[0]))
+	for i := 1; i < len(p); i++ {
+		sum -= math.Abs(e.ringArea(p[i]))
+	}
+
+	return sum
+}
+
+// ringArea returns the area of the ring, positive if counter-clockwise,
+// in the range (-total/2, total/2] where total is the area of the earth.
+func (e *ellipsoid) ringArea(r orb.Ring) float64 {
+	if len(r) < 3 {
+		return 0
+	}
+
+	// the area between each edge and the equator is added up. The result is
+	// off by the area of the earth for each time the ring crosses the
+	// antimeridian, or if it contains a pole.
+	var area float64
+	crossings := 0
+	edge := func(p1, p2 orb.Point) {
+		area += e.inverse(p1[1], p1[0], p2[1], p2[0], true).area
+		crossings += transit(p1[0], p2[0])
+	}
+
+	for i := 1; i < len(r); i++ {
+		edge(r[i-1], r[i])
+	}
+
+	if r[0] != r[len(r)-1] {
+		edge(r[len(r)-1], r[0])
+	}
+
+	total := 4 * math.Pi * e.c2
+	area = math.Remainder(area, total)
+	if crossings%2 != 0 {
+		if area < 0 {
+			area += total / 2
+		} else {
+			area -= total / 2
+		}
+	}
+
+	// the area is clockwise positive at this point
+	area = -area
+	if area > total/2 {
+		area -= total
+	} else if area <= -total/2 {
+		area += total
+	}
+
+	return 0 + area
+}
+
+// transit returns 1 or -1 if the edge from lon1 to lon2 crosses the
+// antimeridian going east or west, 0 otherwise.
+func transit(lon1, lon2 float64) int {
+	lon12, _ := angDiff(lon1, lon2)
+	lon1 = angNormalize(lon1)
+	lon2 = angNormalize(lon2)
+
+	if lon12 > 0 && ((lon1 < 0 && lon2 >= 0) || (lon1 > 0 && lon2 == 0)) {
+		return 1
+	}
+
+	if lon12 < 0 && lon1 >= 0 && lon2 < 0 {
+		return -1
+	}
+
+	return 0
+}
+
+// boundArea returns the area of the bound with edges along the meridians
+// and parallels.
+func (e *ellipsoid) boundArea(b orb.Bound) float64 {
+	if b.IsEmpty() {
+		return 0
+	}
+
+	return deg2rad(b.Max[0]-b.Min[0]) * (e.zoneArea(b.Max[1]) - e.zoneArea(b.Min[1]))
+}
+
+// zoneArea returns the area between the equator and the parallel at the
+// latitude for one radian of longitude.
+func (e *ellipsoid) zoneArea(lat float64) float64 {
+	s := math.Sin(deg2rad(lat))
+	ec := math.Sqrt(e.e2)
+
+	return e.b * e.b / 2 * (s/(1-e.e2*s*s) + math.Atanh(ec*s)/ec)
+}
+
+// boundPerimeter returns the length of the meridians and parallels
+// around the bound.
+func (e *ellipsoid) boundPerimeter(b orb.Bound) float64 {
+	if b.IsEmpty() {
+		return 0
+	}
+
+	west := DistanceGeodesic(b.Min, orb.Point{b.Min[0], b.Max[1]})
+	return 2*west + e.parallelLength(b.Min[1], b.Max[0]-b.Min[0]) +
+		e.parallelLength(b.Max[1], b.Max[0]-b.Min[0])
+}
+
+// parallelLength returns the length along the parallel at the latitude
+// for the longitude difference in degrees.
+func (e *ellipsoid) parallelLength(lat, dlon float64) float64 {
+	s, c := sincosd(lat)
+	return e.a * c / math.Sqrt(1-e.e2*s*s) * deg2rad(dlon)
+}
@@ -0,0 +1,141 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+// antarctica is a rough outline of the continent around the south pole.
+var antarctica = orb.Ring{
+	{-58, -63.1}, {-74, -72.9}, {-102, -71.9}, {-102, -74.9}, {-131, -74.3},
+	{-163, -77.5}, {163, -77.4}, {172, -71.7}, {140, -65.9}, {113, -65.7},
+	{88, -66.6}, {59, -66.9}, {25, -69.8}, {-4, -70.0}, {-14, -71.0},
+	{-33, -77.3}, {-46, -77.9}, {-61, -74.7},
+}
+
+func TestAreaGeodesic(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		// should not panic with unsupported type
+		AreaGeodesic(g)
+	}
+
+	earth := 510065621724088.4
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		expected float64
+	}{
+		{
+			name:     "contains the south pole",
+			geom:     antarctica,
+			expected: 13662703680020.1,
+		},
+		{
+			name:     "octant",
+			geom:     orb.Ring{{0, 0}, {90, 0}, {0, 90}, {0, 0}},
+			expected: earth / 8,
+		},
+		{
+			name: "polygon with a hole",
+			geom: orb.Polygon{
+				{{0, 0}, {90, 0}, {0, 90}, {0, 0}},
+				{{0, 0}, {0, 90}, {90, 0}, {0, 0}},
+			},
+			expected: 0,
+		},
+		{
+			name: "multi polygon",
+			geom: orb.MultiPolygon{
+				{{{0, 0}, {90, 0}, {0, 90}, {0, 0}}},
+				{{{0, 0}, {0, -90}, {90, 0}, {0, 0}}},
+			},
+			expected: earth / 4,
+		},
+		{
+			name: "collection",
+			geom: orb.Collection{
+				orb.Point{1, 2},
+				orb.Ring{{0, 0}, {90, 0}, {0, 90}},
+			},
+			expected: earth / 8,
+		},
+		{
+			name:     "whole earth bound",
+			geom:     orb.Bound{Min: orb.Point{-180, -90}, Max: orb.Point{180, 90}},
+			expected: earth,
+		},
+		{
+			name:     "small bound",
+			geom:     orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			expected: 12308463893.975,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			a := AreaGeodesic(tc.geom)
+			if math.Abs(a-tc.expected) > 0.1 {
+				t.Errorf("incorrect area: %v != %v", a, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAreaGeodesic_smallPolygon(t *testing.T) {
+	// should be close to the spherical approximation
+	p := orb.Polygon{{
+		{-122.4163816, 37.7792782},
+		{-122.4162786, 37.7787626},
+		{-122.4151027, 37.7789118},
+		{-122.4152143, 37.7794274},
+		{-122.4163816, 37.7792782},
+	}}
+
+	a := AreaGeodesic(p)
+	if math.Abs(a-Area(p))/a > 0.005 {
+		t.Errorf("incorrect area: %v != %v", a, Area(p))
+	}
+}
+
+func TestSignedAreaGeodesic(t *testing.T) {
+	r := antarctica.Clone()
+	if a := SignedAreaGeodesic(r); math.Abs(a-13662703680020.1) > 0.1 {
+		t.Errorf("incorrect area: %v", a)
+	}
+
+	r.Reverse()
+	if a := SignedAreaGeodesic(r); math.Abs(a+13662703680020.1) > 0.1 {
+		t.Errorf("incorrect reversed area: %v", a)
+	}
+
+	if a := SignedAreaGeodesic(orb.Ring{{0, 0}, {1, 1}}); a != 0 {
+		t.Errorf("should be 0 for 2 points: %v", a)
+	}
+}
+
+func TestPerimeterGeodesic(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		// should not panic with unsupported type
+		PerimeterGeodesic(g)
+	}
+
+	if p := PerimeterGeodesic(antarctica); math.Abs(p-16831067.893) > 0.001 {
+		t.Errorf("incorrect perimeter: %v", p)
+	}
+
+	b := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}}
+	if p := PerimeterGeodesic(b); math.Abs(p-PerimeterGeodesic(b.ToRing())) > 0.001 {
+		t.Errorf("incorrect bound perimeter: %v", p)
+	}
+
+	if p := PerimeterGeodesic(orb.LineString{{0, 0}, {1, 0}}); p != 0 {
+		t.Errorf("lines should not have a perimeter: %v", p)
+	}
+
+	mp := orb.MultiPolygon{{antarctica}, {antarctica}}
+	if p := PerimeterGeodesic(mp); math.Abs(p-2*16831067.893) > 0.001 {
+		t.Errorf("incorrect multi polygon perimeter: %v", p)
+	}
+}
@@ -0,0 +1,404 @@
+package geo
+
+import "math"
+
+// The helpers and series below follow C. F. F. Karney, Algorithms for
+// geodesics, J. Geodesy 87, 43-55 (2013) and the GeographicLib
+// implementation. The series are expanded to sixth order in the third
+// flattening which gives full double precision for the earth.
+
+const geodesicOrder = 6
+
+var (
+	tiny = math.Sqrt(0x1p-1022) // square root of the smallest normal number
+	tol0 = 0x1p-52
+	tol1 = 200 * tol0
+	tol2 = math.Sqrt(tol0)
+	tolb = tol0 * tol2
+
+	xthresh = 1000 * tol2
+)
+
+// polyval evaluates the polynomial of order n with the coefficients
+// p[s:s+n+1], highest power first, at x.
+func polyval(n int, p []float64, s int, x float64) float64 {
+	if n < 0 {
+		return 0
+	}
+
+	y := p[s]
+	for ; n > 0; n-- {
+		s++
+		y = y*x + p[s]
+	}
+
+	return y
+}
+
+// sum returns the sum of u and v and the roundoff error.
+func sum(u, v float64) (float64, float64) {
+	s := u + v
+	up := s - v
+	vpp := s - up
+	up -= u
+	vpp -= v
+
+	return s, -(up + vpp)
+}
+
+// angRound rounds tiny angles so small differences are not lost
+// when they are added to larger ones.
+func angRound(x float64) float64 {
+	const z = 1.0 / 16
+	y := math.Abs(x)
+	if y < z {
+		y = z - (z - y)
+	}
+
+	return math.Copysign(y, x)
+}
+
+// angNormalize returns the angle in the range (-180, 180].
+func angNormalize(x float64) float64 {
+	y := math.Remainder(x, 360)
+	if math.Abs(y) == 180 {
+		return math.Copysign(180, x)
+	}
+
+	return y
+}
+
+// angDiff returns the exact difference y - x, in (-180, 180],
+// as a rounded value and the error.
+func angDiff(x, y float64) (float64, float64) {
+	d, t := sum(math.Remainder(-x, 360), math.Remainder(y, 360))
+	d, t = sum(math.Remainder(d, 360), t)
+
+	if d == 0 || math.Abs(d) == 180 {
+		if t == 0 {
+			d = math.Copysign(d, y-x)
+		} else {
+			d = math.Copysign(d, -t)
+		}
+	}
+
+	return d, t
+}
+
+// sincosd returns the sine and cosine of the angle in degrees, exactly
+// for multiples of 90 degrees.
+func sincosd(x float64) (float64, float64) {
+	r := math.Mod(x, 360)
+
+	q := 0
+	if !math.IsNaN(r) {
+		q = int(math.Round(r / 90))
+	}
+
+	r -= 90 * float64(q)
+	s, c := math.Sincos(deg2rad(r))
+
+	switch ((q % 4) + 4) % 4 {
+	case 1:
+		s, c = c, -s
+	case 2:
+		s, c = -s, -c
+	case 3:
+		s, c = -c, s
+	}
+
+	c += 0
+	if s == 0 {
+		s = math.Copysign(s, x)
+	}
+
+	return s, c
+}
+
+// atan2d returns atan2(y, x) in degrees, in the range [-180, 180],
+// exactly for multiples of 90 degrees.
+func atan2d(y, x float64) float64 {
+	q := 0
+	if math.Abs(y) > math.Abs(x) {
+		q = 2
+		x, y = y, x
+	}
+
+	if x < 0 {
+		q++
+		x = -x
+	}
+
+	ang := rad2deg(math.Atan2(y, x))
+	switch q {
+	case 1:
+		ang = math.Copysign(180, y) - ang
+	case 2:
+		ang = 90 - ang
+	case 3:
+		ang = -90 + ang
+	}
+
+	return ang
+}
+
+// norm scales the vector to unit length.
+func norm(x, y float64) (float64, float64) {
+	r := math.Hypot(x, y)
+	return x / r, y / r
+}
+
+// sinCosSeries evaluates the sum of c[l] * sin(2*l*x), or cos(2*l*x) + 1
+// if sinp is false, using Clenshaw summation. For the sine series c[0]
+// is not used.
+func sinCosSeries(sinp bool, sinx, cosx float64, c []float64) float64 {
+	k := len(c)
+	n := k
+	if sinp {
+		n--
+	}
+
+	ar := 2 * (cosx - sinx) * (cosx + sinx)
+
+	var y0, y1 float64
+	if n&1 == 1 {
+		k--
+		y0 = c[k]
+	}
+
+	for n /= 2; n > 0; n-- {
+		k--
+		y1 = ar*y0 - y1 + c[k]
+		k--
+		y0 = ar*y1 - y0 + c[k]
+	}
+
+	if sinp {
+		return 2 * sinx * cosx * y0
+	}
+
+	return cosx * (y0 - y1)
+}
+
+// astroid solves k^4 + 2*k^3 - (x^2 + y^2 - 1)*k^2 - 2*y^2*k - y^2 = 0
+// for the positive root k.
+func astroid(x, y float64) float64 {
+	p := x * x
+	q := y * y
+	r := (p + q - 1) / 6
+
+	if q == 0 && r <= 0 {
+		return 0
+	}
+
+	s := p * q / 4
+	r2 := r * r
+	r3 := r * r2
+	disc := s * (s + 2*r3)
+
+	u := r
+	if disc >= 0 {
+		t3 := s + r3
+		if t3 < 0 {
+			t3 -= math.Sqrt(disc)
+		} else {
+			t3 += math.Sqrt(disc)
+		}
+
+		t := math.Cbrt(t3)
+		u += t
+		if t != 0 {
+			u += r2 / t
+		}
+	} else {
+		ang := math.Atan2(math.Sqrt(-disc), -(s + r3))
+		u += 2 * r * math.Cos(ang/3)
+	}
+
+	v := math.Sqrt(u*u + q)
+
+	var uv float64
+	if u < 0 {
+		uv = q / (v - u)
+	} else {
+		uv = u + v
+	}
+
+	w := (uv - q) / (2 * v)
+	return uv / (math.Sqrt(uv+w*w) + w)
+}
+
+// a1m1 returns A1 - 1, the scale of the distance integral.
+func a1m1(eps float64) float64 {
+	coeff := []float64{1, 4, 64, 0, 256}
+	t := polyval(3, coeff, 0, eps*eps) / coeff[4]
+	return (t + eps) / (1 - eps)
+}
+
+// c1 sets the coefficients of the distance integral, c[1:].
+func c1(eps float64, c []float64) {
+	coeff := []float64{
+		-1, 6, -16, 32,
+		-9, 64, -128, 2048,
+		9, -16, 768,
+		3, -5, 512,
+		-7, 1280,
+		-7, 2048,
+	}
+
+	evenSeries(eps, coeff, c)
+}
+
+// c1p sets the coefficients of the inverse of the distance integral, c[1:].
+func c1p(eps float64, c []float64) {
+	coeff := []float64{
+		205, -432, 768, 1536,
+		4005, -4736, 3840, 12288,
+		-225, 116, 384,
+		-7173, 2695, 7680,
+		3467, 7680,
+		38081, 61440,
+	}
+
+	evenSeries(eps, coeff, c)
+}
+
+// a2m1 returns A2 - 1, the scale of the reduced length integral.
+func a2m1(eps float64) float64 {
+	coeff := []float64{-11, -28, -192, 0, 256}
+	t := polyval(3, coeff, 0, eps*eps) / coeff[4]
+	return (t - eps) / (1 + eps)
+}
+
+// c2 sets the coefficients of the reduced length integral, c[1:].
+func c2(eps float64, c []float64) {
+	coeff := []float64{
+		1, 2, 16, 32,
+		35, 64, 384, 2048,
+		15, 80, 768,
+		7, 35, 512,
+		63, 1280,
+		77, 2048,
+	}
+
+	evenSeries(eps, coeff, c)
+}
+
+// a3Coefficients returns the coefficients of A3, the scale of the
+// longitude integral, as a polynomial in eps for the third flattening n.
+func a3Coefficients(n float64) []float64 {
+	coeff := []float64{
+		-3, 128,
+		-2, -3, 64,
+		-1, -3, -1, 16,
+		3, -1, -2, 8,
+		1, -1, 2,
+		1, 1,
+	}
+
+	result := make([]float64, 0, geodesicOrder)
+	o := 0
+	for j := geodesicOrder - 1; j >= 0; j-- {
+		m := geodesicOrder - j - 1
+		if j < m {
+			m = j
+		}
+
+		result = append(result, polyval(m, coeff, o, n)/coeff[o+m+1])
+		o += m + 2
+	}
+
+	return result
+}
+
+// c3Coefficients returns the coefficients of the longitude integral
+// as polynomials in eps for the third flattening n.
+func c3Coefficients(n float64) []float64 {
+	coeff := []float64{
+		3, 128,
+		2, 5, 128,
+		-1, 3, 3, 64,
+		-1, 0, 1, 8,
+		-1, 1, 4,
+		5, 256,
+		1, 3, 128,
+		-3, -2, 3, 64,
+		1, -3, 2, 32,
+		7, 512,
+		-10, 9, 384,
+		5, -9, 5, 192,
+		7, 512,
+		-14, 7, 512,
+		21, 2560,
+	}
+
+	var result []float64
+	o := 0
+	for l := 1; l < geodesicOrder; l++ {
+		for j := geodesicOrder - 1; j >= l; j-- {
+			m := geodesicOrder - j - 1
+			if j < m {
+				m = j
+			}
+
+			result = append(result, polyval(m, coeff, o, n)/coeff[o+m+1])
+			o += m + 2
+		}
+	}
+
+	return result
+}
+
+// c4Coefficients returns the coefficients of the area integral
+// as polynomials in eps for the third flattening n.
+func c4Coefficients(n float64) []float64 {
+	coeff := []float64{
+		97, 15015,
+		1088, 156, 45045,
+		-224, -4784, 1573, 45045,
+		-10656, 14144, -4576, -858, 45045,
+		64, 624, -4576, 6864, -3003, 15015,
+		100, 208, 572, 3432, -12012, 30030, 45045,
+		1, 9009,
+		-2944, 468, 135135,
+		5792, 1040, -1287, 135135,
+		5952, -11648, 9152, -2574, 135135,
+		-64, -624, 4576, -6864, 3003, 135135,
+		8, 10725,
+		1856, -936, 225225,
+		-8448, 4992, -1144, 225225,
+		-1440, 4160, -4576, 1716, 225225,
+		-136, 63063,
+		1024, -208, 105105,
+		3584, -3328, 1144, 315315,
+		-128, 135135,
+		-2560, 832, 405405,
+		128, 99099,
+	}
+
+	var result []float64
+	o := 0
+	for l := 0; l < geodesicOrder; l++ {
+		for j := geodesicOrder - 1; j >= l; j-- {
+			m := geodesicOrder - j - 1
+			result = append(result, polyval(m, coeff, o, n)/coeff[o+m+1])
+			o += m + 2
+		}
+	}
+
+	return result
+}
+
+// evenSeries sets c[l], for l from 1, to eps^l times a polynomial in eps^2
+// with the coefficients, each followed by its divisor.
+func evenSeries(eps float64, coeff, c []float64) {
+	eps2 := eps * eps
+	d := eps
+	o := 0
+	for l := 1; l <= geodesicOrder; l++ {
+		m := (geodesicOrder - l) / 2
+		c[l] = d * polyval(m, coeff, o, eps2) / coeff[o+m+1]
+		o += m + 2
+		d *= eps
+	}
+}
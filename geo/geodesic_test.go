@@ -0,0 +1,149 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestGeodesicInverse(t *testing.T) {
+	cases := []struct {
+		name     string
+		p1, p2   orb.Point
+		distance float64
+		bearing1 float64
+		bearing2 float64
+	}{
+		{
+			name:     "jfk to lhr",
+			p1:       orb.Point{-73.8, 40.6},
+			p2:       orb.Point{-0.5, 51.6},
+			distance: 5551759.400319,
+			bearing1: 51.198883,
+			bearing2: 107.821777,
+		},
+		{
+			name:     "along the equator",
+			p1:       orb.Point{0, 0},
+			p2:       orb.Point{1, 0},
+			distance: 111319.490793,
+			bearing1: 90,
+			bearing2: 90,
+		},
+		{
+			name:     "quarter meridian",
+			p1:       orb.Point{10, 0},
+			p2:       orb.Point{10, 90},
+			distance: 10001965.729313,
+			bearing1: 0,
+			bearing2: 0,
+		},
+		{
+			name:     "antipodal on the equator",
+			p1:       orb.Point{0, 0},
+			p2:       orb.Point{180, 0},
+			distance: 20003931.458625,
+			bearing1: 0,
+			bearing2: 180,
+		},
+		{
+			name:     "across the antimeridian",
+			p1:       orb.Point{179.5, 0},
+			p2:       orb.Point{-179.5, 0},
+			distance: 111319.490793,
+			bearing1: 90,
+			bearing2: 90,
+		},
+		{
+			name:     "same point",
+			p1:       orb.Point{1, 2},
+			p2:       orb.Point{1, 2},
+			distance: 0,
+			bearing1: 180,
+			bearing2: 180,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, b1, b2 := GeodesicInverse(tc.p1, tc.p2)
+			if math.Abs(d-tc.distance) > epsilon {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if math.Abs(b1-tc.bearing1) > epsilon {
+				t.Errorf("incorrect start bearing: %v != %v", b1, tc.bearing1)
+			}
+
+			if math.Abs(b2-tc.bearing2) > epsilon {
+				t.Errorf("incorrect end bearing: %v != %v", b2, tc.bearing2)
+			}
+
+			if v := DistanceGeodesic(tc.p1, tc.p2); v != d {
+				t.Errorf("distance does not match inverse: %v != %v", v, d)
+			}
+		})
+	}
+}
+
+func TestGeodesicInverse_nearlyAntipodal(t *testing.T) {
+	d, b1, b2 := GeodesicInverse(orb.Point{0, -30}, orb.Point{179.8, 30})
+	if math.Abs(d-20000239.437725) > epsilon {
+		t.Errorf("incorrect distance: %v", d)
+	}
+
+	if math.Abs(b1-157.503338) > epsilon || math.Abs(b2-22.496662) > epsilon {
+		t.Errorf("incorrect bearings: %v %v", b1, b2)
+	}
+}
+
+func TestGeodesicDirect(t *testing.T) {
+	p, b := GeodesicDirect(orb.Point{-73.8, 40.6}, 51, 5.5e6)
+
+	expected := orb.Point{-1.141173, 51.884565}
+	if math.Abs(p[0]-expected[0]) > epsilon || math.Abs(p[1]-expected[1]) > epsilon {
+		t.Errorf("incorrect point: %v != %v", p, expected)
+	}
+
+	if math.Abs(b-107.189397) > epsilon {
+		t.Errorf("incorrect bearing: %v", b)
+	}
+
+	if v := PointAtBearingAndDistanceGeodesic(orb.Point{-73.8, 40.6}, 51, 5.5e6); v != p {
+		t.Errorf("point does not match direct: %v != %v", v, p)
+	}
+}
+
+func TestGeodesicDirect_inverse(t *testing.T) {
+	for _, lat := range []float64{-60, -32.06, 0, 40.6, 80} {
+		for _, bearing := range []float64{0, 30, 45, 90, 135, 225, 300} {
+			for _, dist := range []float64{1e3, 1e6, 1e7, 1.99e7} {
+				start := orb.Point{10, lat}
+				end := PointAtBearingAndDistanceGeodesic(start, bearing, dist)
+
+				d, b, _ := GeodesicInverse(start, end)
+				if math.Abs(d-dist) > 1e-6 {
+					t.Errorf("%v %v %v: incorrect distance: %v", lat, bearing, dist, d)
+				}
+
+				if math.Abs(math.Remainder(b-bearing, 360)) > 1e-9 {
+					t.Errorf("%v %v %v: incorrect bearing: %v", lat, bearing, dist, b)
+				}
+			}
+		}
+	}
+}
+
+func TestLengthGeodesic(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {1, 0}, {1, 0}, {2, 0}}
+	if l := LengthGeodesic(ls); math.Abs(l-2*111319.490793) > epsilon {
+		t.Errorf("incorrect length: %v", l)
+	}
+
+	// the sphere is off by about 0.3% at the poles
+	meridian := orb.LineString{{0, 0}, {0, 90}}
+	if l := LengthGeodesic(meridian); math.Abs(l-LengthHaversine(meridian)) < 10000 {
+		t.Errorf("should be different from the sphere: %v", l)
+	}
+}
@@ -0,0 +1,65 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/linref"
+)
+
+// LocatePoint returns the fraction of the length of the line string, from
+// 0 to 1, to the point on the line closest to p. The line segments are great
+// circle paths and the lengths are computed using the haversine formula.
+// Multiply by LengthHaversine of the line for the distance along it in meters.
+// Empty and zero length lines return 0.
+func LocatePoint(ls orb.LineString, p orb.Point) float64 {
+	return linref.Locate(ls, p, DistanceHaversine, closestOnSegment)
+}
+
+// InterpolatePoint returns the point at the fraction of the length of the
+// line string following the great circle paths between the points.
+// The fraction is clamped to [0, 1]. Empty lines return the zero point.
+// The longitude of the point is kept in [-180, 180].
+func InterpolatePoint(ls orb.LineString, fraction float64) orb.Point {
+	return linref.Interpolate(ls, fraction, DistanceHaversine, intermediatePoint)
+}
+
+// Substring returns the part of the line string between the two fractions
+// of its length, following the great circle paths between the points.
+// The fractions are clamped to [0, 1]. If start is greater than end the
+// result is reversed, from start to end. The result always has at least
+// two points, these will be the same if start equals end. The longitude
+// of the new end points is kept in [-180, 180].
+func Substring(ls orb.LineString, start, end float64) orb.LineString {
+	return linref.Substring(ls, start, end, DistanceHaversine, intermediatePoint)
+}
+
+// closestOnSegment returns the fraction along the great circle segment [a, b]
+// of the point closest to p and the distance to that point in meters.
+func closestOnSegment(a, b, p orb.Point) (float64, float64) {
+	d12 := DistanceHaversine(a, b) / orb.EarthRadius
+	d13 := DistanceHaversine(a, p) / orb.EarthRadius
+	if d12 == 0 {
+		return 0, d13 * orb.EarthRadius
+	}
+
+	dBearing := deg2rad(Bearing(a, p) - Bearing(a, b))
+
+	// cross and along track distances as angles
+	xt := math.Asin(math.Sin(d13) * math.Sin(dBearing))
+	at := math.Acos(math.Max(-1, math.Min(1, math.Cos(d13)/math.Cos(xt))))
+	if math.Cos(dBearing) < 0 {
+		at = -at
+	}
+
+	t := at / d12
+	if t <= 0 {
+		return 0, d13 * orb.EarthRadius
+	}
+
+	if t >= 1 {
+		return 1, DistanceHaversine(b, p)
+	}
+
+	return t, math.Abs(xt) * orb.EarthRadius
+}
@@ -0,0 +1,96 @@
+package geo
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestLocatePoint(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {1, 0}, {1, 1}}
+
+	if f := LocatePoint(ls, orb.Point{0.5, 0.1}); math.Abs(f-0.25) > 1e-3 {
+		t.Errorf("incorrect fraction: %v", f)
+	}
+
+	if f := LocatePoint(ls, orb.Point{-1, 0}); f != 0 {
+		t.Errorf("incorrect fraction: %v", f)
+	}
+
+	if f := LocatePoint(ls, orb.Point{1.5, 2}); f != 1 {
+		t.Errorf("incorrect fraction: %v", f)
+	}
+
+	// the great circle between the points bends toward the pole
+	ls = orb.LineString{{-60, 60}, {60, 60}}
+	if f := LocatePoint(ls, orb.Point{0, 70}); math.Abs(f-0.5) > epsilon {
+		t.Errorf("incorrect fraction: %v", f)
+	}
+
+	north := InterpolatePoint(ls, 0.5)
+	if f := LocatePoint(ls, north); math.Abs(f-0.5) > epsilon {
+		t.Errorf("incorrect fraction: %v", f)
+	}
+}
+
+func TestInterpolatePoint(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {1, 0}, {1, 1}}
+
+	if p := InterpolatePoint(ls, 0.25); math.Abs(p[0]-0.5) > epsilon || math.Abs(p[1]) > epsilon {
+		t.Errorf("incorrect point: %v", p)
+	}
+
+	if p := InterpolatePoint(ls, 1); !p.Equal(orb.Point{1, 1}) {
+		t.Errorf("incorrect point: %v", p)
+	}
+
+	// the midpoint of a great circle across the antimeridian
+	ls = orb.LineString{{179, 10}, {-179, 10}}
+	p := InterpolatePoint(ls, 0.5)
+	if math.Abs(math.Abs(p[0])-180) > epsilon || p[1] < 10 {
+		t.Errorf("incorrect point: %v", p)
+	}
+
+	if m := Midpoint(ls[0], ls[1]); math.Abs(m[1]-p[1]) > epsilon {
+		t.Errorf("should match midpoint: %v != %v", m, p)
+	}
+
+	// the longitude is normalized past the antimeridian
+	ls = orb.LineString{{179, 0}, {-179, 0}}
+	p = InterpolatePoint(ls, 0.75)
+	if math.Abs(p[0]+179.5) > epsilon || math.Abs(p[1]) > epsilon {
+		t.Errorf("incorrect point: %v", p)
+	}
+}
+
+func TestSubstring(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {1, 0}, {1, 1}}
+
+	s := Substring(ls, 0.25, 0.75)
+	if len(s) != 3 || !s[1].Equal(orb.Point{1, 0}) {
+		t.Fatalf("incorrect substring: %v", s)
+	}
+
+	if d := LengthHaversine(s); math.Abs(d-LengthHaversine(ls)/2) > 1e-3 {
+		t.Errorf("incorrect length: %v", d)
+	}
+
+	r := Substring(ls, 0.75, 0.25)
+	if !r[0].Equal(s[2]) || !r[2].Equal(s[0]) {
+		t.Errorf("should be reversed: %v", r)
+	}
+
+	// the end points are normalized past the antimeridian
+	s = Substring(orb.LineString{{179, 0}, {-179, 0}}, 0.25, 0.75)
+	expected := orb.LineString{{179.5, 0}, {-179.5, 0}}
+	if len(s) != 2 {
+		t.Fatalf("incorrect substring: %v", s)
+	}
+
+	for i := range s {
+		if math.Abs(s[i][0]-expected[i][0]) > epsilon || math.Abs(s[i][1]-expected[i][1]) > epsilon {
+			t.Errorf("incorrect point: %v != %v", s[i], expected[i])
+		}
+	}
+}
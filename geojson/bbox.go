@@ -1,12 +1,16 @@
 package geojson
 
-import "github.com/paulmach/orb"
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+)
 
 // BBox is for the geojson bbox attribute which is an array with all axes
 // of the most southwesterly point followed by all axes of the more northeasterly point.
 type BBox []float64
 
-// NewBBox creates a bbox from a a bound.
+// NewBBox creates a bbox from a a bound. Use NewBBox(orb.Bound(b)) for
+// a geo.Bound that crosses the antimeridian.
 func NewBBox(b orb.Bound) BBox {
 	return []float64{
 		b.Min[0], b.Min[1],
@@ -23,7 +27,9 @@ func (bb BBox) Valid() bool {
 	return len(bb) >= 4 && len(bb)%2 == 0
 }
 
-// Bound returns the orb.Bound for the BBox.
+// Bound returns the orb.Bound for the BBox. A bbox that crosses the
+// antimeridian, with the west edge larger than the east edge, results in
+// an empty bound, use GeoBound for those.
 func (bb BBox) Bound() orb.Bound {
 	if !bb.Valid() {
 		return orb.Bound{}
@@ -36,3 +42,10 @@ func (bb BBox) Bound() orb.Bound {
 		Max: orb.Point{bb[mid], bb[mid+1]},
 	}
 }
+
+// GeoBound returns the geographic bound for the BBox. As RFC 7946 section
+// 5.2 describes, the west edge can be larger than the east edge if the bbox
+// crosses the antimeridian.
+func (bb BBox) GeoBound() geo.Bound {
+	return geo.Bound(bb.Bound())
+}
@@ -92,3 +92,21 @@ func TestBBoxBound(t *testing.T) {
 	}
 
 }
+
+func TestBBoxGeoBound(t *testing.T) {
+	// fiji, across the antimeridian
+	bbox := BBox{177, -20, -178, -12}
+
+	b := bbox.GeoBound()
+	if !b.CrossesAntimeridian() {
+		t.Errorf("should cross the antimeridian: %v", b)
+	}
+
+	if !b.Contains(orb.Point{179, -16}) || !b.Contains(orb.Point{-179, -16}) {
+		t.Errorf("should contain points on both sides: %v", b)
+	}
+
+	if v := NewBBox(orb.Bound(b)); !reflect.DeepEqual(v, bbox) {
+		t.Errorf("should round trip: %v != %v", v, bbox)
+	}
+}
@@ -53,10 +53,11 @@ func (f Feature) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalFeature decodes the data into a GeoJSON feature.
-// Alternately one can call json.Unmarshal(f) directly for the same result.
-func UnmarshalFeature(data []byte) (*Feature, error) {
+// Alternately one can call json.Unmarshal(f) directly for the same result
+// without the options.
+func UnmarshalFeature(data []byte, opts ...UnmarshalOption) (*Feature, error) {
 	f := &Feature{}
-	err := f.UnmarshalJSON(data)
+	err := f.unmarshal(data, newUnmarshalOptions(opts))
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +68,11 @@ func UnmarshalFeature(data []byte) (*Feature, error) {
 // UnmarshalJSON handles the correct unmarshalling of the data
 // into the orb.Geometry types.
 func (f *Feature) UnmarshalJSON(data []byte) error {
-	jf := &jsonFeature{}
+	return f.unmarshal(data, unmarshalOptions{})
+}
+
+func (f *Feature) unmarshal(data []byte, opts unmarshalOptions) error {
+	jf := &jsonFeatureUnmarshal{}
 	err := unmarshalJSON(data, &jf)
 	if err != nil {
 		return err
@@ -78,11 +83,17 @@ func (f *Feature) UnmarshalJSON(data []byte) error {
 	}
 
 	var g orb.Geometry
-	if jf.Geometry != nil {
-		if jf.Geometry.Coordinates == nil && jf.Geometry.Geometries == nil {
+	if !isNull(jf.Geometry) {
+		jg := &Geometry{}
+		err := jg.unmarshal(jf.Geometry, opts)
+		if err != nil {
+			return err
+		}
+
+		if jg.Coordinates == nil && jg.Geometries == nil {
 			return ErrInvalidGeometry
 		}
-		g = jf.Geometry.Geometry()
+		g = jg.Geometry()
 	}
 
 	*f = Feature{
@@ -103,3 +114,13 @@ type jsonFeature struct {
 	Geometry   *Geometry   `json:"geometry"`
 	Properties Properties  `json:"properties"`
 }
+
+// jsonFeatureUnmarshal keeps the raw geometry so it can be
+// decoded with the options.
+type jsonFeatureUnmarshal struct {
+	ID         interface{}      `json:"id,omitempty"`
+	Type       string           `json:"type"`
+	BBox       BBox             `json:"bbox,omitempty"`
+	Geometry   nocopyRawMessage `json:"geometry"`
+	Properties Properties       `json:"properties"`
+}
@@ -68,6 +68,10 @@ func (fc FeatureCollection) MarshalJSON() ([]byte, error) {
 // UnmarshalJSON decodes the data into a GeoJSON feature collection.
 // Extra/foreign members will be put into the `ExtraMembers` attribute.
 func (fc *FeatureCollection) UnmarshalJSON(data []byte) error {
+	return fc.unmarshal(data, unmarshalOptions{})
+}
+
+func (fc *FeatureCollection) unmarshal(data []byte, opts unmarshalOptions) error {
 	tmp := make(map[string]nocopyRawMessage, 4)
 
 	err := unmarshalJSON(data, &tmp)
@@ -89,7 +93,13 @@ func (fc *FeatureCollection) UnmarshalJSON(data []byte) error {
 				return err
 			}
 		case "features":
-			err := unmarshalJSON(value, &fc.Features)
+			var features []nocopyRawMessage
+			err := unmarshalJSON(value, &features)
+			if err != nil {
+				return err
+			}
+
+			fc.Features, err = unmarshalFeatures(features, opts)
 			if err != nil {
 				return err
 			}
@@ -114,12 +124,36 @@ func (fc *FeatureCollection) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func unmarshalFeatures(data []nocopyRawMessage, opts unmarshalOptions) ([]*Feature, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	features := make([]*Feature, 0, len(data))
+	for _, d := range data {
+		if isNull(d) {
+			features = append(features, nil)
+			continue
+		}
+
+		f := &Feature{}
+		err := f.unmarshal(d, opts)
+		if err != nil {
+			return nil, err
+		}
+		features = append(features, f)
+	}
+
+	return features, nil
+}
+
 // UnmarshalFeatureCollection decodes the data into a GeoJSON feature collection.
-// Alternately one can call json.Unmarshal(fc) directly for the same result.
-func UnmarshalFeatureCollection(data []byte) (*FeatureCollection, error) {
+// Alternately one can call json.Unmarshal(fc) directly for the same result
+// without the options.
+func UnmarshalFeatureCollection(data []byte, opts ...UnmarshalOption) (*FeatureCollection, error) {
 	fc := &FeatureCollection{}
 
-	err := fc.UnmarshalJSON(data)
+	err := fc.unmarshal(data, newUnmarshalOptions(opts))
 	if err != nil {
 		return nil, err
 	}
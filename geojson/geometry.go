@@ -4,6 +4,7 @@ import (
 	"errors"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 // ErrInvalidGeometry will be returned if a the json of the geometry is invalid.
@@ -90,10 +91,11 @@ func (g Geometry) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalGeometry decodes the data into a GeoJSON feature.
-// Alternately one can call json.Unmarshal(g) directly for the same result.
-func UnmarshalGeometry(data []byte) (*Geometry, error) {
+// Alternately one can call json.Unmarshal(g) directly for the same result
+// without the options.
+func UnmarshalGeometry(data []byte, opts ...UnmarshalOption) (*Geometry, error) {
 	g := &Geometry{}
-	err := unmarshalJSON(data, g)
+	err := g.unmarshal(data, newUnmarshalOptions(opts))
 	if err != nil {
 		return nil, err
 	}
@@ -103,12 +105,26 @@ func UnmarshalGeometry(data []byte) (*Geometry, error) {
 
 // UnmarshalJSON will unmarshal the correct geometry from the json structure.
 func (g *Geometry) UnmarshalJSON(data []byte) error {
+	return g.unmarshal(data, unmarshalOptions{})
+}
+
+func (g *Geometry) unmarshal(data []byte, opts unmarshalOptions) error {
 	jg := &jsonGeometry{}
 	err := unmarshalJSON(data, jg)
 	if err != nil {
 		return err
 	}
 
+	if opts.zm && jg.Type != "GeometryCollection" {
+		g.Coordinates, err = unmarshalZM(jg.Type, jg.Coordinates)
+		if err != nil {
+			return err
+		}
+
+		g.Type = g.Coordinates.GeoJSONType()
+		return nil
+	}
+
 	switch jg.Type {
 	case "Point":
 		p := orb.Point{}
@@ -135,7 +151,7 @@ func (g *Geometry) UnmarshalJSON(data []byte) error {
 		err = unmarshalJSON(jg.Coordinates, &mp)
 		g.Coordinates = mp
 	case "GeometryCollection":
-		g.Geometries = jg.Geometries
+		g.Geometries, err = unmarshalGeometries(jg.Geometries, opts)
 	default:
 		return ErrInvalidGeometry
 	}
@@ -166,7 +182,7 @@ func (p *Point) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	point, ok := g.Coordinates.(orb.Point)
+	point, ok := zm.ToXY(g.Coordinates).(orb.Point)
 	if !ok {
 		return errors.New("geojson: not a Point type")
 	}
@@ -196,7 +212,7 @@ func (mp *MultiPoint) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	multiPoint, ok := g.Coordinates.(orb.MultiPoint)
+	multiPoint, ok := zm.ToXY(g.Coordinates).(orb.MultiPoint)
 	if !ok {
 		return errors.New("geojson: not a MultiPoint type")
 	}
@@ -226,7 +242,7 @@ func (ls *LineString) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	lineString, ok := g.Coordinates.(orb.LineString)
+	lineString, ok := zm.ToXY(g.Coordinates).(orb.LineString)
 	if !ok {
 		return errors.New("geojson: not a LineString type")
 	}
@@ -256,7 +272,7 @@ func (mls *MultiLineString) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	multilineString, ok := g.Coordinates.(orb.MultiLineString)
+	multilineString, ok := zm.ToXY(g.Coordinates).(orb.MultiLineString)
 	if !ok {
 		return errors.New("geojson: not a MultiLineString type")
 	}
@@ -286,7 +302,7 @@ func (p *Polygon) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	polygon, ok := g.Coordinates.(orb.Polygon)
+	polygon, ok := zm.ToXY(g.Coordinates).(orb.Polygon)
 	if !ok {
 		return errors.New("geojson: not a Polygon type")
 	}
@@ -316,7 +332,7 @@ func (mp *MultiPolygon) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	multiPolygon, ok := g.Coordinates.(orb.MultiPolygon)
+	multiPolygon, ok := zm.ToXY(g.Coordinates).(orb.MultiPolygon)
 	if !ok {
 		return errors.New("geojson: not a MultiPolygon type")
 	}
@@ -325,10 +341,33 @@ func (mp *MultiPolygon) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+func unmarshalGeometries(data []nocopyRawMessage, opts unmarshalOptions) ([]*Geometry, error) {
+	if data == nil {
+		return nil, nil
+	}
+
+	geometries := make([]*Geometry, 0, len(data))
+	for _, d := range data {
+		if isNull(d) {
+			geometries = append(geometries, nil)
+			continue
+		}
+
+		g := &Geometry{}
+		err := g.unmarshal(d, opts)
+		if err != nil {
+			return nil, err
+		}
+		geometries = append(geometries, g)
+	}
+
+	return geometries, nil
+}
+
 type jsonGeometry struct {
-	Type        string           `json:"type"`
-	Coordinates nocopyRawMessage `json:"coordinates"`
-	Geometries  []*Geometry      `json:"geometries,omitempty"`
+	Type        string             `json:"type"`
+	Coordinates nocopyRawMessage   `json:"coordinates"`
+	Geometries  []nocopyRawMessage `json:"geometries,omitempty"`
 }
 
 type jsonGeometryMarshall struct {
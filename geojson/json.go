@@ -66,6 +66,28 @@ func unmarshalJSON(data []byte, v interface{}) error {
 	return CustomJSONUnmarshaler.Unmarshal(data, v)
 }
 
+// An UnmarshalOption changes how the Unmarshal functions, e.g.
+// UnmarshalFeatureCollection, decode the data.
+type UnmarshalOption func(*unmarshalOptions)
+
+type unmarshalOptions struct {
+	zm bool
+}
+
+func newUnmarshalOptions(opts []UnmarshalOption) unmarshalOptions {
+	var o unmarshalOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	return o
+}
+
+// isNull returns true for the raw value of a missing or null member.
+func isNull(data []byte) bool {
+	return len(data) == 0 || string(data) == "null"
+}
+
 type nocopyRawMessage []byte
 
 func (m *nocopyRawMessage) UnmarshalJSON(data []byte) error {
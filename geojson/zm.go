@@ -0,0 +1,108 @@
+package geojson
+
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// WithZM is an unmarshal option to decode positions with 3 or 4 values into
+// the orb types with z, or z and m, values, e.g. orb.PointZ or orb.LineStringZM.
+// The layout of a geometry is defined by its first position, missing values
+// are set to zero and extra values are dropped. By default only the
+// x and y values are kept.
+//
+// GeoJSON has no way to mark a measure without an elevation so the
+// orb types with only m values, e.g. orb.PointM, will encode
+// positions that decode as z values.
+func WithZM() UnmarshalOption {
+	return func(o *unmarshalOptions) {
+		o.zm = true
+	}
+}
+
+func unmarshalZM(typ string, data []byte) (orb.Geometry, error) {
+	var (
+		l   zm.Layout
+		set bool
+	)
+
+	coord := func(vs []float64) zm.Coord {
+		if !set {
+			set = true
+			switch {
+			case len(vs) >= 4:
+				l = zm.XYZM
+			case len(vs) == 3:
+				l = zm.XYZ
+			}
+		}
+
+		var c zm.Coord
+		for i := 0; i < len(vs) && i < l.Size(); i++ {
+			c[i] = vs[i]
+		}
+		return c
+	}
+
+	coords := func(vs [][]float64) []zm.Coord {
+		result := make([]zm.Coord, 0, len(vs))
+		for _, v := range vs {
+			result = append(result, coord(v))
+		}
+		return result
+	}
+
+	lines := func(vs [][][]float64) [][]zm.Coord {
+		result := make([][]zm.Coord, 0, len(vs))
+		for _, v := range vs {
+			result = append(result, coords(v))
+		}
+		return result
+	}
+
+	switch typ {
+	case "Point":
+		var vs []float64
+		if err := unmarshalJSON(data, &vs); err != nil {
+			return nil, err
+		}
+
+		c := coord(vs)
+		return zm.Point(c, l), nil
+	case "MultiPoint", "LineString":
+		var vs [][]float64
+		if err := unmarshalJSON(data, &vs); err != nil {
+			return nil, err
+		}
+
+		cs := coords(vs)
+		if typ == "MultiPoint" {
+			return zm.MultiPoint(cs, l), nil
+		}
+		return zm.LineString(cs, l), nil
+	case "MultiLineString", "Polygon":
+		var vs [][][]float64
+		if err := unmarshalJSON(data, &vs); err != nil {
+			return nil, err
+		}
+
+		ls := lines(vs)
+		if typ == "Polygon" {
+			return zm.Polygon(ls, l), nil
+		}
+		return zm.MultiLineString(ls, l), nil
+	case "MultiPolygon":
+		var vs [][][][]float64
+		if err := unmarshalJSON(data, &vs); err != nil {
+			return nil, err
+		}
+
+		polygons := make([][][]zm.Coord, 0, len(vs))
+		for _, v := range vs {
+			polygons = append(polygons, lines(v))
+		}
+		return zm.MultiPolygon(polygons, l), nil
+	}
+
+	return nil, ErrInvalidGeometry
+}
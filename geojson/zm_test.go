@@ -0,0 +1,159 @@
+package geojson
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestGeometry_zm(t *testing.T) {
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		expected string
+	}{
+		{
+			name:     "point z",
+			geom:     orb.PointZ{1, 2, 3},
+			expected: `{"type":"Point","coordinates":[1,2,3]}`,
+		},
+		{
+			name:     "point zm",
+			geom:     orb.PointZM{1, 2, 3, 4},
+			expected: `{"type":"Point","coordinates":[1,2,3,4]}`,
+		},
+		{
+			name:     "line string z",
+			geom:     orb.LineStringZ{{1, 2, 3}, {4, 5, 6}},
+			expected: `{"type":"LineString","coordinates":[[1,2,3],[4,5,6]]}`,
+		},
+		{
+			name:     "multi point zm",
+			geom:     orb.MultiPointZM{{1, 2, 3, 4}},
+			expected: `{"type":"MultiPoint","coordinates":[[1,2,3,4]]}`,
+		},
+		{
+			name:     "multi line string z",
+			geom:     orb.MultiLineStringZ{{{1, 2, 3}}, {{4, 5, 6}}},
+			expected: `{"type":"MultiLineString","coordinates":[[[1,2,3]],[[4,5,6]]]}`,
+		},
+		{
+			name:     "polygon z",
+			geom:     orb.PolygonZ{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}},
+			expected: `{"type":"Polygon","coordinates":[[[0,0,1],[1,0,2],[1,1,3],[0,0,1]]]}`,
+		},
+		{
+			name:     "multi polygon zm",
+			geom:     orb.MultiPolygonZM{{{{0, 0, 1, 2}, {1, 0, 2, 3}, {0, 0, 1, 2}}}},
+			expected: `{"type":"MultiPolygon","coordinates":[[[[0,0,1,2],[1,0,2,3],[0,0,1,2]]]]}`,
+		},
+		{
+			name:     "2d",
+			geom:     orb.LineString{{1, 2}, {3, 4}},
+			expected: `{"type":"LineString","coordinates":[[1,2],[3,4]]}`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := NewGeometry(tc.geom).MarshalJSON()
+			if err != nil {
+				t.Fatalf("marshal error: %v", err)
+			}
+
+			if string(data) != tc.expected {
+				t.Errorf("incorrect json: %v", string(data))
+			}
+
+			g, err := UnmarshalGeometry(data, WithZM())
+			if err != nil {
+				t.Fatalf("unmarshal error: %v", err)
+			}
+
+			if !orb.Equal(g.Geometry(), tc.geom) {
+				t.Errorf("incorrect geometry: %v", g.Geometry())
+			}
+		})
+	}
+}
+
+func TestGeometry_zmMixed(t *testing.T) {
+	g, err := UnmarshalGeometry([]byte(`{"type":"LineString","coordinates":[[1,2,3],[4,5],[6,7,8,9]]}`), WithZM())
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	expected := orb.LineStringZ{{1, 2, 3}, {4, 5, 0}, {6, 7, 8}}
+	if !orb.Equal(g.Geometry(), expected) {
+		t.Errorf("incorrect geometry: %v", g.Geometry())
+	}
+
+	// the helper types always drop the extra values
+	p := Point{}
+	err = p.UnmarshalJSON([]byte(`{"type":"Point","coordinates":[1,2,3]}`))
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if p != (Point{1, 2}) {
+		t.Errorf("incorrect point: %v", p)
+	}
+}
+
+func TestGeometry_zmDisabled(t *testing.T) {
+	g, err := UnmarshalGeometry([]byte(`{"type":"Point","coordinates":[1,2,3]}`))
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !orb.Equal(g.Geometry(), orb.Point{1, 2}) {
+		t.Errorf("incorrect geometry: %v", g.Geometry())
+	}
+}
+
+func TestFeatureCollection_zm(t *testing.T) {
+	data := []byte(`{"type":"FeatureCollection","features":[
+		{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2,3]},"properties":{}},
+		{"type":"Feature","geometry":{"type":"GeometryCollection","geometries":[
+			{"type":"LineString","coordinates":[[1,2,3,4],[5,6,7,8]]}
+		]},"properties":{}},
+		{"type":"Feature","geometry":null,"properties":{}}
+	]}`)
+
+	fc, err := UnmarshalFeatureCollection(data, WithZM())
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !orb.Equal(fc.Features[0].Geometry, orb.PointZ{1, 2, 3}) {
+		t.Errorf("incorrect point: %v", fc.Features[0].Geometry)
+	}
+
+	expected := orb.Collection{orb.LineStringZM{{1, 2, 3, 4}, {5, 6, 7, 8}}}
+	if !orb.Equal(fc.Features[1].Geometry, expected) {
+		t.Errorf("incorrect collection: %v", fc.Features[1].Geometry)
+	}
+
+	if fc.Features[2].Geometry != nil {
+		t.Errorf("geometry should be nil: %v", fc.Features[2].Geometry)
+	}
+
+	// the option only applies to the call
+	fc, err = UnmarshalFeatureCollection(data)
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !orb.Equal(fc.Features[0].Geometry, orb.Point{1, 2}) {
+		t.Errorf("incorrect point: %v", fc.Features[0].Geometry)
+	}
+
+	f, err := UnmarshalFeature([]byte(`{"type":"Feature","geometry":{"type":"Point","coordinates":[1,2,3,4]},"properties":{}}`), WithZM())
+	if err != nil {
+		t.Fatalf("unmarshal error: %v", err)
+	}
+
+	if !orb.Equal(f.Geometry, orb.PointZM{1, 2, 3, 4}) {
+		t.Errorf("incorrect feature geometry: %v", f.Geometry)
+	}
+}
@@ -61,6 +61,42 @@ var AllGeometries = []Geometry{
 
 	// Collection of Collection
 	Collection{Collection{Point{}}},
+
+	// with z and/or m values
+	PointZ{},
+	MultiPointZ{},
+	LineStringZ{},
+	MultiLineStringZ{},
+	PolygonZ{},
+	MultiPolygonZ{},
+	PointM{},
+	MultiPointM{},
+	LineStringM{},
+	MultiLineStringM{},
+	PolygonM{},
+	MultiPolygonM{},
+	PointZM{},
+	MultiPointZM{},
+	LineStringZM{},
+	MultiLineStringZM{},
+	PolygonZM{},
+	MultiPolygonZM{},
+
+	MultiPointZ(nil),
+	LineStringZ(nil),
+	MultiLineStringZ(nil),
+	PolygonZ(nil),
+	MultiPolygonZ(nil),
+	MultiPointM(nil),
+	LineStringM(nil),
+	MultiLineStringM(nil),
+	PolygonM(nil),
+	MultiPolygonM(nil),
+	MultiPointZM(nil),
+	LineStringZM(nil),
+	MultiLineStringZM(nil),
+	PolygonZM(nil),
+	MultiPolygonZM(nil),
 }
 
 // A Collection is a collection of geometries that is also a Geometry.
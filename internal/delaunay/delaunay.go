@@ -0,0 +1,516 @@
+// Package delaunay computes the Delaunay triangulation of a set of points.
+// It is a port of the sweep-hull algorithm used by
+// https://github.com/mapbox/delaunator
+package delaunay
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/predicates"
+)
+
+var epsilon = math.Pow(2, -52)
+
+// Triangulation is the result of a Delaunay triangulation.
+// Triangles are stored as a flat slice of point indexes, three for each
+// triangle, in counter-clockwise order. Half-edge e goes from point
+// Triangles[e] to Triangles[Next(e)]. Halfedges[e] is the index of the
+// opposite half-edge in the adjacent triangle, or -1 if on the convex hull.
+type Triangulation struct {
+	Points    []orb.Point
+	Triangles []int
+	Halfedges []int
+
+	// Hull is the indexes of the points on the convex hull
+	// in counter-clockwise order.
+	Hull []int
+}
+
+// Next returns the next half-edge in the same triangle.
+func Next(e int) int {
+	if e%3 == 2 {
+		return e - 2
+	}
+	return e + 1
+}
+
+// Prev returns the previous half-edge in the same triangle.
+func Prev(e int) int {
+	if e%3 == 0 {
+		return e + 2
+	}
+	return e - 1
+}
+
+type triangulator struct {
+	coords []float64
+
+	triangles []int
+	halfedges []int
+
+	hashSize  int
+	hullStart int
+	hullPrev  []int
+	hullNext  []int
+	hullTri   []int
+	hullHash  []int
+
+	cx, cy float64
+	stack  []int
+}
+
+// New triangulates the points. Duplicate points are ignored.
+// If all the points are collinear there will be no triangles and
+// the hull will contain the points sorted along the line.
+func New(points []orb.Point) *Triangulation {
+	n := len(points)
+
+	// The algorithm was written for y-down screen coordinates, flipping
+	// the y axis makes the output counter-clockwise in y-up coordinates.
+	coords := make([]float64, 2*n)
+	for i, p := range points {
+		coords[2*i] = p[0]
+		coords[2*i+1] = -p[1]
+	}
+
+	maxTriangles := 2*n - 5
+	if maxTriangles < 0 {
+		maxTriangles = 0
+	}
+
+	t := &triangulator{
+		coords:    coords,
+		triangles: make([]int, 0, maxTriangles*3),
+		halfedges: make([]int, 0, maxTriangles*3),
+		hashSize:  int(math.Ceil(math.Sqrt(float64(n)))),
+		hullPrev:  make([]int, n),
+		hullNext:  make([]int, n),
+		hullTri:   make([]int, n),
+	}
+	t.hullHash = make([]int, t.hashSize)
+
+	hull := t.triangulate()
+	return &Triangulation{
+		Points:    points,
+		Triangles: t.triangles,
+		Halfedges: t.halfedges,
+		Hull:      hull,
+	}
+}
+
+func (t *triangulator) triangulate() []int {
+	coords := t.coords
+	n := len(coords) / 2
+	if n == 0 {
+		return nil
+	}
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+	ids := make([]int, n)
+	for i := 0; i < n; i++ {
+		x, y := coords[2*i], coords[2*i+1]
+		minX = math.Min(minX, x)
+		minY = math.Min(minY, y)
+		maxX = math.Max(maxX, x)
+		maxY = math.Max(maxY, y)
+		ids[i] = i
+	}
+	cx := (minX + maxX) / 2
+	cy := (minY + maxY) / 2
+
+	// pick a seed point close to the center
+	i0, i1, i2 := -1, -1, -1
+	minDist := math.Inf(1)
+	for i := 0; i < n; i++ {
+		if d := dist(cx, cy, coords[2*i], coords[2*i+1]); d < minDist {
+			i0 = i
+			minDist = d
+		}
+	}
+	i0x, i0y := coords[2*i0], coords[2*i0+1]
+
+	// find the point closest to the seed
+	minDist = math.Inf(1)
+	for i := 0; i < n; i++ {
+		if i == i0 {
+			continue
+		}
+
+		if d := dist(i0x, i0y, coords[2*i], coords[2*i+1]); d < minDist && d > 0 {
+			i1 = i
+			minDist = d
+		}
+	}
+
+	// find the third point which forms the smallest circumcircle with the first two
+	minRadius := math.Inf(1)
+	if i1 != -1 {
+		i1x, i1y := coords[2*i1], coords[2*i1+1]
+		for i := 0; i < n; i++ {
+			if i == i0 || i == i1 {
+				continue
+			}
+
+			r := circumradius(i0x, i0y, i1x, i1y, coords[2*i], coords[2*i+1])
+			if r < minRadius {
+				i2 = i
+				minRadius = r
+			}
+		}
+	}
+
+	if math.IsInf(minRadius, 1) || math.IsNaN(minRadius) {
+		// order collinear points by dx (or dy if all x are identical)
+		// and return the list as a hull
+		dists := make([]float64, n)
+		for i := 0; i < n; i++ {
+			dists[i] = coords[2*i] - coords[0]
+			if dists[i] == 0 {
+				dists[i] = coords[2*i+1] - coords[1]
+			}
+		}
+
+		sort.SliceStable(ids, func(i, j int) bool {
+			return dists[ids[i]] < dists[ids[j]]
+		})
+
+		hull := make([]int, 0, n)
+		d0 := math.Inf(-1)
+		for _, id := range ids {
+			if d := dists[id]; d > d0 {
+				hull = append(hull, id)
+				d0 = d
+			}
+		}
+
+		return hull
+	}
+
+	// swap the order of the seed points for counter-clockwise orientation
+	if orient2d(i0x, i0y, coords[2*i1], coords[2*i1+1], coords[2*i2], coords[2*i2+1]) < 0 {
+		i1, i2 = i2, i1
+	}
+	i1x, i1y := coords[2*i1], coords[2*i1+1]
+	i2x, i2y := coords[2*i2], coords[2*i2+1]
+
+	t.cx, t.cy = circumcenter(i0x, i0y, i1x, i1y, i2x, i2y)
+
+	dists := make([]float64, n)
+	for i := 0; i < n; i++ {
+		dists[i] = dist(coords[2*i], coords[2*i+1], t.cx, t.cy)
+	}
+
+	// sort the points by distance from the seed triangle circumcenter
+	sort.SliceStable(ids, func(i, j int) bool {
+		return dists[ids[i]] < dists[ids[j]]
+	})
+
+	// set up the seed triangle as the starting hull
+	t.hullStart = i0
+	hullSize := 3
+
+	hullNext, hullPrev, hullTri, hullHash := t.hullNext, t.hullPrev, t.hullTri, t.hullHash
+	hullNext[i0], hullPrev[i2] = i1, i1
+	hullNext[i1], hullPrev[i0] = i2, i2
+	hullNext[i2], hullPrev[i1] = i0, i0
+
+	hullTri[i0] = 0
+	hullTri[i1] = 1
+	hullTri[i2] = 2
+
+	for i := range hullHash {
+		hullHash[i] = -1
+	}
+	hullHash[t.hashKey(i0x, i0y)] = i0
+	hullHash[t.hashKey(i1x, i1y)] = i1
+	hullHash[t.hashKey(i2x, i2y)] = i2
+
+	t.addTriangle(i0, i1, i2, -1, -1, -1)
+
+	var xp, yp float64
+	for k, i := range ids {
+		x, y := coords[2*i], coords[2*i+1]
+
+		// skip near-duplicate points
+		if k > 0 && math.Abs(x-xp) <= epsilon && math.Abs(y-yp) <= epsilon {
+			continue
+		}
+		xp, yp = x, y
+
+		// skip seed triangle points
+		if i == i0 || i == i1 || i == i2 {
+			continue
+		}
+
+		// find a visible edge on the convex hull using edge hash
+		start := 0
+		key := t.hashKey(x, y)
+		for j := 0; j < t.hashSize; j++ {
+			start = hullHash[(key+j)%t.hashSize]
+			if start != -1 && start != hullNext[start] {
+				break
+			}
+		}
+
+		start = hullPrev[start]
+		e := start
+		for {
+			q := hullNext[e]
+			if orient2d(x, y, coords[2*e], coords[2*e+1], coords[2*q], coords[2*q+1]) < 0 {
+				break
+			}
+
+			e = q
+			if e == start {
+				e = -1
+				break
+			}
+		}
+
+		if e == -1 {
+			continue // likely a near-duplicate point; skip it
+		}
+
+		// add the first triangle from the point
+		tri := t.addTriangle(e, i, hullNext[e], -1, -1, hullTri[e])
+
+		// recursively flip triangles from the point until they satisfy the Delaunay condition
+		hullTri[i] = t.legalize(tri + 2)
+		hullTri[e] = tri // keep track of boundary triangles on the hull
+		hullSize++
+
+		// walk forward through the hull, adding more triangles and flipping recursively
+		nx := hullNext[e]
+		for {
+			q := hullNext[nx]
+			if orient2d(x, y, coords[2*nx], coords[2*nx+1], coords[2*q], coords[2*q+1]) >= 0 {
+				break
+			}
+
+			tri = t.addTriangle(nx, i, q, hullTri[i], -1, hullTri[nx])
+			hullTri[i] = t.legalize(tri + 2)
+			hullNext[nx] = nx // mark as removed
+			hullSize--
+			nx = q
+		}
+
+		// walk backward from the other side, adding more triangles and flipping
+		if e == start {
+			for {
+				q := hullPrev[e]
+				if orient2d(x, y, coords[2*q], coords[2*q+1], coords[2*e], coords[2*e+1]) >= 0 {
+					break
+				}
+
+				tri = t.addTriangle(q, i, e, -1, hullTri[e], hullTri[q])
+				t.legalize(tri + 2)
+				hullTri[q] = tri
+				hullNext[e] = e // mark as removed
+				hullSize--
+				e = q
+			}
+		}
+
+		// update the hull indices
+		t.hullStart = e
+		hullPrev[i] = e
+		hullNext[e] = i
+		hullPrev[nx] = i
+		hullNext[i] = nx
+
+		// save the two new edges in the hash table
+		hullHash[t.hashKey(x, y)] = i
+		hullHash[t.hashKey(coords[2*e], coords[2*e+1])] = e
+	}
+
+	hull := make([]int, 0, hullSize)
+	e := t.hullStart
+	for i := 0; i < hullSize; i++ {
+		hull = append(hull, e)
+		e = hullNext[e]
+	}
+
+	return hull
+}
+
+func (t *triangulator) hashKey(x, y float64) int {
+	k := int(math.Floor(pseudoAngle(x-t.cx, y-t.cy)*float64(t.hashSize))) % t.hashSize
+	if k < 0 {
+		k += t.hashSize
+	}
+	return k
+}
+
+// legalize flips the triangles from the half-edge until they all
+// satisfy the Delaunay condition. A stack is used instead of recursion.
+//
+//	      pl                    pl
+//	     /||\                  /  \
+//	  al/ || \bl            al/    \a
+//	   /  ||  \              /      \
+//	  /  a||b  \    flip    /___ar___\
+//	p0\   ||   /p1   =>   p0\---bl---/p1
+//	   \  ||  /              \      /
+//	  ar\ || /br             b\    /br
+//	     \||/                  \  /
+//	      pr                    pr
+func (t *triangulator) legalize(a int) int {
+	triangles, halfedges, coords := t.triangles, t.halfedges, t.coords
+	stack := t.stack[:0]
+
+	ar := 0
+	for {
+		b := halfedges[a]
+
+		a0 := a - a%3
+		ar = a0 + (a+2)%3
+
+		if b == -1 { // convex hull edge
+			if len(stack) == 0 {
+				break
+			}
+
+			a = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		b0 := b - b%3
+		al := a0 + (a+1)%3
+		bl := b0 + (b+2)%3
+
+		p0 := triangles[ar]
+		pr := triangles[a]
+		pl := triangles[al]
+		p1 := triangles[bl]
+
+		illegal := inCircle(
+			coords[2*p0], coords[2*p0+1],
+			coords[2*pr], coords[2*pr+1],
+			coords[2*pl], coords[2*pl+1],
+			coords[2*p1], coords[2*p1+1],
+		)
+
+		if !illegal {
+			if len(stack) == 0 {
+				break
+			}
+
+			a = stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		triangles[a] = p1
+		triangles[b] = p0
+
+		hbl := halfedges[bl]
+
+		// edge swapped on the other side of the hull (rare); fix the halfedge reference
+		if hbl == -1 {
+			e := t.hullStart
+			for {
+				if t.hullTri[e] == bl {
+					t.hullTri[e] = a
+					break
+				}
+
+				e = t.hullPrev[e]
+				if e == t.hullStart {
+					break
+				}
+			}
+		}
+
+		t.link(a, hbl)
+		t.link(b, halfedges[ar])
+		t.link(ar, bl)
+
+		br := b0 + (b+1)%3
+		stack = append(stack, br)
+	}
+
+	t.stack = stack
+	return ar
+}
+
+func (t *triangulator) link(a, b int) {
+	t.halfedges[a] = b
+	if b != -1 {
+		t.halfedges[b] = a
+	}
+}
+
+// addTriangle adds a new triangle given vertex indices and adjacent half-edge ids.
+func (t *triangulator) addTriangle(i0, i1, i2, a, b, c int) int {
+	e := len(t.triangles)
+
+	t.triangles = append(t.triangles, i0, i1, i2)
+	t.halfedges = append(t.halfedges, -1, -1, -1)
+
+	t.link(e, a)
+	t.link(e+1, b)
+	t.link(e+2, c)
+
+	return e
+}
+
+// pseudoAngle monotonically increases with real angle,
+// but doesn't need expensive trigonometry.
+func pseudoAngle(dx, dy float64) float64 {
+	p := dx / (math.Abs(dx) + math.Abs(dy))
+	if dy > 0 {
+		return (3 - p) / 4 // [0..1]
+	}
+
+	return (1 + p) / 4 // [0..1]
+}
+
+func dist(ax, ay, bx, by float64) float64 {
+	dx := ax - bx
+	dy := ay - by
+	return dx*dx + dy*dy
+}
+
+func orient2d(ax, ay, bx, by, cx, cy float64) float64 {
+	return -predicates.Orient2D(ax, ay, bx, by, cx, cy)
+}
+
+func inCircle(ax, ay, bx, by, cx, cy, px, py float64) bool {
+	return predicates.InCircle(ax, ay, bx, by, cx, cy, px, py) < 0
+}
+
+func circumradius(ax, ay, bx, by, cx, cy float64) float64 {
+	dx := bx - ax
+	dy := by - ay
+	ex := cx - ax
+	ey := cy - ay
+
+	bl := dx*dx + dy*dy
+	cl := ex*ex + ey*ey
+	d := 0.5 / (dx*ey - dy*ex)
+
+	x := (ey*bl - dy*cl) * d
+	y := (dx*cl - ex*bl) * d
+
+	return x*x + y*y
+}
+
+func circumcenter(ax, ay, bx, by, cx, cy float64) (float64, float64) {
+	dx := bx - ax
+	dy := by - ay
+	ex := cx - ax
+	ey := cy - ay
+
+	bl := dx*dx + dy*dy
+	cl := ex*ex + ey*ey
+	d := 0.5 / (dx*ey - dy*ex)
+
+	x := ax + (ey*bl-dy*cl)*d
+	y := ay + (dx*cl-ex*bl)*d
+
+	return x, y
+}
@@ -0,0 +1,132 @@
+package delaunay
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestNew(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+
+	for _, n := range []int{3, 10, 100, 1000} {
+		points := make([]orb.Point, 0, n)
+		for i := 0; i < n; i++ {
+			points = append(points, orb.Point{r.Float64() * 100, r.Float64() * 100})
+		}
+
+		tri := New(points)
+		checkTriangulation(t, tri)
+
+		if l := len(tri.Triangles) / 3; l != 2*n-2-len(tri.Hull) {
+			t.Errorf("incorrect number of triangles: %v", l)
+		}
+	}
+}
+
+func TestNew_grid(t *testing.T) {
+	// lots of cocircular points
+	var points []orb.Point
+	for x := 0; x < 10; x++ {
+		for y := 0; y < 10; y++ {
+			points = append(points, orb.Point{float64(x), float64(y)})
+		}
+	}
+
+	tri := New(points)
+	checkTriangulation(t, tri)
+
+	if l := len(tri.Triangles) / 3; l != 162 {
+		t.Errorf("incorrect number of triangles: %v", l)
+	}
+}
+
+func TestNew_degenerate(t *testing.T) {
+	tri := New(nil)
+	if len(tri.Triangles) != 0 || len(tri.Hull) != 0 {
+		t.Errorf("should be empty: %v", tri)
+	}
+
+	tri = New([]orb.Point{{1, 1}, {1, 1}})
+	if len(tri.Triangles) != 0 || len(tri.Hull) != 1 {
+		t.Errorf("should have one hull point: %v", tri)
+	}
+
+	tri = New([]orb.Point{{2, 2}, {0, 0}, {3, 3}, {1, 1}})
+	if len(tri.Triangles) != 0 {
+		t.Errorf("collinear points should have no triangles: %v", tri)
+	}
+
+	if h := tri.Hull; len(h) != 4 || h[0] != 1 || h[1] != 3 || h[2] != 0 || h[3] != 2 {
+		t.Errorf("incorrect hull: %v", h)
+	}
+
+	tri = New([]orb.Point{{0, 0}, {1, 0}, {1, 0}, {0, 1}})
+	checkTriangulation(t, tri)
+	if l := len(tri.Triangles); l != 3 {
+		t.Errorf("duplicate point should be ignored: %v", tri.Triangles)
+	}
+}
+
+func checkTriangulation(t testing.TB, tri *Triangulation) {
+	t.Helper()
+
+	points := tri.Points
+	for e, o := range tri.Halfedges {
+		if o != -1 && tri.Halfedges[o] != e {
+			t.Fatalf("halfedges are not linked: %v %v", e, o)
+		}
+
+		if o != -1 && tri.Triangles[e] != tri.Triangles[Next(o)] {
+			t.Fatalf("halfedges are not opposite: %v %v", e, o)
+		}
+	}
+
+	for i := 0; i < len(tri.Triangles); i += 3 {
+		a := points[tri.Triangles[i]]
+		b := points[tri.Triangles[i+1]]
+		c := points[tri.Triangles[i+2]]
+
+		if cross(a, b, c) <= 0 {
+			t.Fatalf("triangle not counter-clockwise: %v %v %v", a, b, c)
+		}
+
+		for _, p := range points {
+			if p == a || p == b || p == c {
+				continue
+			}
+
+			if inCircumcircle(a, b, c, p) > 1e-9 {
+				t.Fatalf("point in circumcircle: %v %v %v %v", a, b, c, p)
+			}
+		}
+	}
+
+	hull := tri.Hull
+	for i := range hull {
+		a := points[hull[i]]
+		b := points[hull[(i+1)%len(hull)]]
+		for _, p := range points {
+			if cross(a, b, p) < 0 {
+				t.Fatalf("point outside hull: %v", p)
+			}
+		}
+	}
+}
+
+func cross(a, b, c orb.Point) float64 {
+	return (b[0]-a[0])*(c[1]-a[1]) - (b[1]-a[1])*(c[0]-a[0])
+}
+
+// inCircumcircle is positive if p is inside the circumcircle
+// of the counter-clockwise triangle a, b, c.
+func inCircumcircle(a, b, c, p orb.Point) float64 {
+	adx, ady := a[0]-p[0], a[1]-p[1]
+	bdx, bdy := b[0]-p[0], b[1]-p[1]
+	cdx, cdy := c[0]-p[0], c[1]-p[1]
+
+	return (adx*adx+ady*ady)*(bdx*cdy-cdx*bdy) -
+		(bdx*bdx+bdy*bdy)*(adx*cdy-cdx*ady) +
+		(cdx*cdx+cdy*cdy)*(adx*bdy-bdx*ady)
+}
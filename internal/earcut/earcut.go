@@ -0,0 +1,710 @@
+// Package earcut splits polygons into triangles using ear clipping.
+// It is a port of https://github.com/mapbox/earcut
+package earcut
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+)
+
+// Triangulate returns the triangles of the polygon as indexes into the
+// points of the rings appended together, three for each triangle in
+// counter-clockwise order.
+func Triangulate(p orb.Polygon) []int {
+	if len(p) == 0 {
+		return nil
+	}
+
+	ec := &earcut{}
+
+	var start int
+	var outer *earNode
+	var holes []*earNode
+	for i, r := range p {
+		if i == 0 {
+			outer = linkedList(r, start, true)
+		} else if list := linkedList(r, start, false); list != nil {
+			if list == list.next {
+				list.steiner = true
+			}
+			holes = append(holes, list.leftmost())
+		}
+		start += len(r)
+	}
+
+	if outer == nil || outer.next == outer.prev {
+		return nil
+	}
+
+	if len(holes) > 0 {
+		outer = ec.eliminateHoles(holes, outer)
+	}
+
+	// if the shape is not too simple use a z-order curve hash later
+	if start > 80 {
+		b := p[0].Bound()
+		ec.minX, ec.minY = b.Min[0], b.Min[1]
+
+		size := math.Max(b.Max[0]-b.Min[0], b.Max[1]-b.Min[1])
+		if size != 0 {
+			ec.invSize = 32767 / size
+		}
+	}
+
+	ec.earcutLinked(outer, 0)
+	return ec.triangles
+}
+
+type earNode struct {
+	i    int
+	x, y float64
+
+	prev, next *earNode
+
+	// z-order curve value and the nodes in z-order
+	z            int32
+	prevZ, nextZ *earNode
+
+	// a hole that is a single point
+	steiner bool
+}
+
+type earcut struct {
+	triangles []int
+
+	minX, minY, invSize float64
+}
+
+// linkedList creates a circular doubly linked list from the ring.
+// The outer ring is linked counter-clockwise and the holes clockwise.
+func linkedList(r orb.Ring, start int, outer bool) *earNode {
+	if len(r) == 0 {
+		return nil
+	}
+
+	var last *earNode
+	if (r.Orientation() == orb.CCW) == outer {
+		for i, p := range r {
+			last = insertEarNode(start+i, p, last)
+		}
+	} else {
+		for i := len(r) - 1; i >= 0; i-- {
+			last = insertEarNode(start+i, r[i], last)
+		}
+	}
+
+	if last != nil && last.equals(last.next) {
+		last.remove()
+		last = last.next
+	}
+
+	return last
+}
+
+// filterPoints removes collinear or duplicate points.
+func filterPoints(start, end *earNode) *earNode {
+	if start == nil {
+		return start
+	}
+
+	if end == nil {
+		end = start
+	}
+
+	p := start
+	for {
+		again := false
+		if !p.steiner && (p.equals(p.next) || earArea(p.prev, p, p.next) == 0) {
+			p.remove()
+			p = p.prev
+			end = p
+			if p == p.next {
+				break
+			}
+			again = true
+		} else {
+			p = p.next
+		}
+
+		if !again && p == end {
+			break
+		}
+	}
+
+	return end
+}
+
+// earcutLinked is the main ear slicing loop that triangulates the polygon.
+func (ec *earcut) earcutLinked(ear *earNode, pass int) {
+	if ear == nil {
+		return
+	}
+
+	// interlink polygon nodes in z-order
+	if pass == 0 && ec.invSize != 0 {
+		ec.indexCurve(ear)
+	}
+
+	stop := ear
+	for ear.prev != ear.next {
+		prev, next := ear.prev, ear.next
+
+		var isEar bool
+		if ec.invSize != 0 {
+			isEar = ec.isEarHashed(ear)
+		} else {
+			isEar = ear.isEar()
+		}
+
+		if isEar {
+			ec.triangles = append(ec.triangles, prev.i, ear.i, next.i)
+			ear.remove()
+
+			// skipping the next vertex leads to less sliver triangles
+			ear = next.next
+			stop = next.next
+			continue
+		}
+
+		ear = next
+
+		// looped through the whole remaining polygon and can't find any more ears
+		if ear == stop {
+			switch pass {
+			case 0:
+				// try filtering points and slicing again
+				ec.earcutLinked(filterPoints(ear, nil), 1)
+			case 1:
+				// try curing all small self-intersections locally
+				ear = ec.cureLocalIntersections(filterPoints(ear, nil))
+				ec.earcutLinked(ear, 2)
+			case 2:
+				// as a last resort, try splitting the remaining polygon into two
+				ec.splitEarcut(ear)
+			}
+
+			break
+		}
+	}
+}
+
+// isEar checks if the node forms a valid ear with the adjacent nodes.
+func (n *earNode) isEar() bool {
+	a, b, c := n.prev, n, n.next
+	if earArea(a, b, c) >= 0 {
+		// reflex, can't be an ear
+		return false
+	}
+
+	// make sure there are no other points inside the potential ear
+	x0, y0 := math.Min(a.x, math.Min(b.x, c.x)), math.Min(a.y, math.Min(b.y, c.y))
+	x1, y1 := math.Max(a.x, math.Max(b.x, c.x)), math.Max(a.y, math.Max(b.y, c.y))
+
+	for p := c.next; p != a; p = p.next {
+		if p.x >= x0 && p.x <= x1 && p.y >= y0 && p.y <= y1 &&
+			pointInTriangle(a, b, c, p) && earArea(p.prev, p, p.next) >= 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ec *earcut) isEarHashed(ear *earNode) bool {
+	a, b, c := ear.prev, ear, ear.next
+	if earArea(a, b, c) >= 0 {
+		// reflex, can't be an ear
+		return false
+	}
+
+	x0, y0 := math.Min(a.x, math.Min(b.x, c.x)), math.Min(a.y, math.Min(b.y, c.y))
+	x1, y1 := math.Max(a.x, math.Max(b.x, c.x)), math.Max(a.y, math.Max(b.y, c.y))
+
+	// z-order range for the current triangle bound
+	minZ := ec.zOrder(x0, y0)
+	maxZ := ec.zOrder(x1, y1)
+
+	inside := func(p *earNode) bool {
+		return p.x >= x0 && p.x <= x1 && p.y >= y0 && p.y <= y1 && p != a && p != c &&
+			pointInTriangle(a, b, c, p) && earArea(p.prev, p, p.next) >= 0
+	}
+
+	// look for points inside the triangle in both directions
+	p, n := ear.prevZ, ear.nextZ
+	for p != nil && p.z >= minZ && n != nil && n.z <= maxZ {
+		if inside(p) {
+			return false
+		}
+		p = p.prevZ
+
+		if inside(n) {
+			return false
+		}
+		n = n.nextZ
+	}
+
+	// look for remaining points in decreasing z-order
+	for ; p != nil && p.z >= minZ; p = p.prevZ {
+		if inside(p) {
+			return false
+		}
+	}
+
+	// look for remaining points in increasing z-order
+	for ; n != nil && n.z <= maxZ; n = n.nextZ {
+		if inside(n) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// cureLocalIntersections goes through all the nodes and cures
+// small local self-intersections.
+func (ec *earcut) cureLocalIntersections(start *earNode) *earNode {
+	p := start
+	for {
+		a, b := p.prev, p.next.next
+
+		if !a.equals(b) && earIntersects(a, p, p.next, b) && locallyInside(a, b) && locallyInside(b, a) {
+			ec.triangles = append(ec.triangles, a.i, p.i, b.i)
+
+			// remove the two nodes involved
+			p.remove()
+			p.next.remove()
+
+			p = b
+			start = b
+		}
+
+		p = p.next
+		if p == start {
+			break
+		}
+	}
+
+	return filterPoints(p, nil)
+}
+
+// splitEarcut tries splitting the polygon into two and
+// triangulates them independently.
+func (ec *earcut) splitEarcut(start *earNode) {
+	// look for a valid diagonal that divides the polygon into two
+	a := start
+	for {
+		for b := a.next.next; b != a.prev; b = b.next {
+			if a.i != b.i && isValidDiagonal(a, b) {
+				c := splitPolygon(a, b)
+
+				// filter collinear points around the cuts
+				a = filterPoints(a, a.next)
+				c = filterPoints(c, c.next)
+
+				ec.earcutLinked(a, 0)
+				ec.earcutLinked(c, 0)
+				return
+			}
+		}
+
+		a = a.next
+		if a == start {
+			return
+		}
+	}
+}
+
+// eliminateHoles links every hole into the outer ring,
+// producing a single ring polygon without holes.
+func (ec *earcut) eliminateHoles(holes []*earNode, outer *earNode) *earNode {
+	sort.SliceStable(holes, func(i, j int) bool {
+		return holes[i].x < holes[j].x
+	})
+
+	// process holes from left to right
+	for _, h := range holes {
+		outer = eliminateHole(h, outer)
+	}
+
+	return outer
+}
+
+// eliminateHole finds a bridge between the hole and the outer ring and links it.
+func eliminateHole(hole, outer *earNode) *earNode {
+	bridge := findHoleBridge(hole, outer)
+	if bridge == nil {
+		return outer
+	}
+
+	bridgeReverse := splitPolygon(bridge, hole)
+
+	// filter collinear points around the cuts
+	filterPoints(bridgeReverse, bridgeReverse.next)
+	return filterPoints(bridge, bridge.next)
+}
+
+// findHoleBridge uses David Eberly's algorithm for finding
+// a bridge between the hole and the outer ring.
+func findHoleBridge(hole, outer *earNode) *earNode {
+	hx, hy := hole.x, hole.y
+	qx := math.Inf(-1)
+
+	// find a segment intersected by a ray from the hole's leftmost point to the left,
+	// the segment's endpoint with lesser x will be the potential connection point
+	var m *earNode
+	p := outer
+	for {
+		if hy <= p.y && hy >= p.next.y && p.next.y != p.y {
+			x := p.x + (hy-p.y)*(p.next.x-p.x)/(p.next.y-p.y)
+			if x <= hx && x > qx {
+				qx = x
+				m = p
+				if p.next.x < p.x {
+					m = p.next
+				}
+
+				if x == hx {
+					// hole touches outer segment, pick leftmost endpoint
+					return m
+				}
+			}
+		}
+
+		p = p.next
+		if p == outer {
+			break
+		}
+	}
+
+	if m == nil {
+		return nil
+	}
+
+	// look for points inside the triangle of the hole point, segment intersection
+	// and endpoint. If there are no points found there is a valid connection,
+	// otherwise choose the point of the minimum angle with the ray.
+	stop := m
+	mx, my := m.x, m.y
+	tanMin := math.Inf(1)
+
+	ax, cx := hx, qx
+	if hy >= my {
+		ax, cx = qx, hx
+	}
+
+	p = m
+	for {
+		if hx >= p.x && p.x >= mx && hx != p.x &&
+			pointInTriangleXY(ax, hy, mx, my, cx, hy, p.x, p.y) {
+
+			tan := math.Abs(hy-p.y) / (hx - p.x)
+			if locallyInside(p, hole) &&
+				(tan < tanMin || (tan == tanMin && (p.x > m.x || (p.x == m.x && sectorContainsSector(m, p))))) {
+				m = p
+				tanMin = tan
+			}
+		}
+
+		p = p.next
+		if p == stop {
+			break
+		}
+	}
+
+	return m
+}
+
+// sectorContainsSector checks if the sector in vertex m contains
+// the sector in vertex p in the same coordinates.
+func sectorContainsSector(m, p *earNode) bool {
+	return earArea(m.prev, m, p.prev) < 0 && earArea(p.next, m, m.next) < 0
+}
+
+// indexCurve interlinks the nodes in z-order.
+func (ec *earcut) indexCurve(start *earNode) {
+	p := start
+	for {
+		if p.z == 0 {
+			p.z = ec.zOrder(p.x, p.y)
+		}
+
+		p.prevZ = p.prev
+		p.nextZ = p.next
+		p = p.next
+
+		if p == start {
+			break
+		}
+	}
+
+	p.prevZ.nextZ = nil
+	p.prevZ = nil
+
+	sortLinked(p)
+}
+
+// sortLinked is Simon Tatham's linked list merge sort algorithm.
+func sortLinked(list *earNode) *earNode {
+	inSize := 1
+	for {
+		p := list
+		list = nil
+
+		var tail *earNode
+		numMerges := 0
+
+		for p != nil {
+			numMerges++
+
+			q := p
+			pSize := 0
+			for i := 0; i < inSize; i++ {
+				pSize++
+				q = q.nextZ
+				if q == nil {
+					break
+				}
+			}
+
+			qSize := inSize
+			for pSize > 0 || (qSize > 0 && q != nil) {
+				var e *earNode
+				if pSize != 0 && (qSize == 0 || q == nil || p.z <= q.z) {
+					e = p
+					p = p.nextZ
+					pSize--
+				} else {
+					e = q
+					q = q.nextZ
+					qSize--
+				}
+
+				if tail != nil {
+					tail.nextZ = e
+				} else {
+					list = e
+				}
+
+				e.prevZ = tail
+				tail = e
+			}
+
+			p = q
+		}
+
+		tail.nextZ = nil
+		inSize *= 2
+
+		if numMerges <= 1 {
+			return list
+		}
+	}
+}
+
+// zOrder returns the z-order of the point, the coordinates are transformed
+// into the non-negative 15-bit integer range.
+func (ec *earcut) zOrder(fx, fy float64) int32 {
+	x := int32((fx - ec.minX) * ec.invSize)
+	y := int32((fy - ec.minY) * ec.invSize)
+
+	x = (x | (x << 8)) & 0x00FF00FF
+	x = (x | (x << 4)) & 0x0F0F0F0F
+	x = (x | (x << 2)) & 0x33333333
+	x = (x | (x << 1)) & 0x55555555
+
+	y = (y | (y << 8)) & 0x00FF00FF
+	y = (y | (y << 4)) & 0x0F0F0F0F
+	y = (y | (y << 2)) & 0x33333333
+	y = (y | (y << 1)) & 0x55555555
+
+	return x | (y << 1)
+}
+
+// leftmost returns the leftmost node of the ring.
+func (n *earNode) leftmost() *earNode {
+	p, leftmost := n, n
+	for {
+		if p.x < leftmost.x || (p.x == leftmost.x && p.y < leftmost.y) {
+			leftmost = p
+		}
+
+		p = p.next
+		if p == n {
+			return leftmost
+		}
+	}
+}
+
+// pointInTriangle checks if the point lies within the convex triangle.
+func pointInTriangle(a, b, c, p *earNode) bool {
+	return pointInTriangleXY(a.x, a.y, b.x, b.y, c.x, c.y, p.x, p.y)
+}
+
+func pointInTriangleXY(ax, ay, bx, by, cx, cy, px, py float64) bool {
+	return (cx-px)*(ay-py) >= (ax-px)*(cy-py) &&
+		(ax-px)*(by-py) >= (bx-px)*(ay-py) &&
+		(bx-px)*(cy-py) >= (cx-px)*(by-py)
+}
+
+// isValidDiagonal checks if a diagonal between two nodes is valid,
+// i.e. it lies in the polygon interior.
+func isValidDiagonal(a, b *earNode) bool {
+	if a.next.i == b.i || a.prev.i == b.i || intersectsPolygon(a, b) {
+		return false
+	}
+
+	// locally visible and does not create opposite-facing sectors
+	if locallyInside(a, b) && locallyInside(b, a) && middleInside(a, b) &&
+		(earArea(a.prev, a, b.prev) != 0 || earArea(a, b.prev, b) != 0) {
+		return true
+	}
+
+	// special zero-length case
+	return a.equals(b) && earArea(a.prev, a, a.next) > 0 && earArea(b.prev, b, b.next) > 0
+}
+
+// earArea returns twice the signed area of the triangle, it is negative
+// for a convex corner of a counter-clockwise ring.
+func earArea(p, q, r *earNode) float64 {
+	return (q.y-p.y)*(r.x-q.x) - (q.x-p.x)*(r.y-q.y)
+}
+
+func (n *earNode) equals(q *earNode) bool {
+	return n.x == q.x && n.y == q.y
+}
+
+// earIntersects checks if the two segments intersect.
+func earIntersects(p1, q1, p2, q2 *earNode) bool {
+	o1 := sign(earArea(p1, q1, p2))
+	o2 := sign(earArea(p1, q1, q2))
+	o3 := sign(earArea(p2, q2, p1))
+	o4 := sign(earArea(p2, q2, q1))
+
+	if o1 != o2 && o3 != o4 {
+		// general case
+		return true
+	}
+
+	// collinear and the point lies on the other segment
+	return (o1 == 0 && earOnSegment(p1, p2, q1)) ||
+		(o2 == 0 && earOnSegment(p1, q2, q1)) ||
+		(o3 == 0 && earOnSegment(p2, p1, q2)) ||
+		(o4 == 0 && earOnSegment(p2, q1, q2))
+}
+
+// earOnSegment checks if q lies on the segment pr, for collinear points p, q, r.
+func earOnSegment(p, q, r *earNode) bool {
+	return q.x <= math.Max(p.x, r.x) && q.x >= math.Min(p.x, r.x) &&
+		q.y <= math.Max(p.y, r.y) && q.y >= math.Min(p.y, r.y)
+}
+
+func sign(v float64) int {
+	if v > 0 {
+		return 1
+	}
+
+	if v < 0 {
+		return -1
+	}
+
+	return 0
+}
+
+// intersectsPolygon checks if the diagonal intersects any of the polygon segments.
+func intersectsPolygon(a, b *earNode) bool {
+	p := a
+	for {
+		if p.i != a.i && p.next.i != a.i && p.i != b.i && p.next.i != b.i &&
+			earIntersects(p, p.next, a, b) {
+			return true
+		}
+
+		p = p.next
+		if p == a {
+			return false
+		}
+	}
+}
+
+// locallyInside checks if the diagonal is locally inside the polygon.
+func locallyInside(a, b *earNode) bool {
+	if earArea(a.prev, a, a.next) < 0 {
+		return earArea(a, b, a.next) >= 0 && earArea(a, a.prev, b) >= 0
+	}
+
+	return earArea(a, b, a.prev) < 0 || earArea(a, a.next, b) < 0
+}
+
+// middleInside checks if the middle point of the diagonal is inside the polygon.
+func middleInside(a, b *earNode) bool {
+	inside := false
+	px, py := (a.x+b.x)/2, (a.y+b.y)/2
+
+	p := a
+	for {
+		if (p.y > py) != (p.next.y > py) && p.next.y != p.y &&
+			px < (p.next.x-p.x)*(py-p.y)/(p.next.y-p.y)+p.x {
+			inside = !inside
+		}
+
+		p = p.next
+		if p == a {
+			return inside
+		}
+	}
+}
+
+// splitPolygon links two vertices with a bridge. If the vertices belong to
+// the same ring it splits the polygon into two, if one belongs to the outer
+// ring and the other to a hole it merges them into a single ring.
+func splitPolygon(a, b *earNode) *earNode {
+	a2 := &earNode{i: a.i, x: a.x, y: a.y}
+	b2 := &earNode{i: b.i, x: b.x, y: b.y}
+	an, bp := a.next, b.prev
+
+	a.next = b
+	b.prev = a
+
+	a2.next = an
+	an.prev = a2
+
+	b2.next = a2
+	a2.prev = b2
+
+	bp.next = b2
+	b2.prev = bp
+
+	return b2
+}
+
+// insertEarNode creates a node and links it with the previous one.
+func insertEarNode(i int, p orb.Point, last *earNode) *earNode {
+	n := &earNode{i: i, x: p[0], y: p[1]}
+
+	if last == nil {
+		n.prev = n
+		n.next = n
+	} else {
+		n.next = last.next
+		n.prev = last
+		last.next.prev = n
+		last.next = n
+	}
+
+	return n
+}
+
+func (n *earNode) remove() {
+	n.next.prev = n.prev
+	n.prev.next = n.next
+
+	if n.prevZ != nil {
+		n.prevZ.nextZ = n.nextZ
+	}
+
+	if n.nextZ != nil {
+		n.nextZ.prevZ = n.prevZ
+	}
+}
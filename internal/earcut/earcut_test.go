@@ -0,0 +1,329 @@
+package earcut
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestTriangulate(t *testing.T) {
+	square := orb.Ring{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}
+	hole := orb.Ring{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}}
+
+	cases := []struct {
+		name      string
+		polygon   orb.Polygon
+		triangles int
+	}{
+		{
+			name:      "square with counter-clockwise hole",
+			polygon:   orb.Polygon{square, reverse(hole)},
+			triangles: 8,
+		},
+		{
+			name: "two holes",
+			polygon: orb.Polygon{
+				{{0, 0}, {10, 0}, {10, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+				{{6, 1}, {6, 3}, {8, 3}, {8, 1}, {6, 1}},
+			},
+			// the bridge between the holes is collinear with their edges
+			triangles: 12,
+		},
+		{
+			name:      "hole that is a single point",
+			polygon:   orb.Polygon{square, {{2, 2}}},
+			triangles: 4,
+		},
+		{
+			name:      "duplicate points",
+			polygon:   orb.Polygon{{{0, 0}, {4, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 4}, {0, 0}}},
+			triangles: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tris := Triangulate(tc.polygon)
+			if len(tris) != 3*tc.triangles {
+				t.Errorf("incorrect number of triangles: %d != %d", len(tris)/3, tc.triangles)
+			}
+
+			checkTriangles(t, tc.polygon, tris)
+		})
+	}
+}
+
+func TestTriangulate_hashed(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	// star shapes are concave and have more than 80 points
+	star := func(cx, cy, radius float64, n int, hole bool) orb.Ring {
+		ring := make(orb.Ring, 0, n+1)
+		for i := 0; i < n; i++ {
+			a := 2 * math.Pi * float64(i) / float64(n)
+			d := radius * (0.5 + 0.5*r.Float64())
+			ring = append(ring, orb.Point{cx + d*math.Cos(a), cy + d*math.Sin(a)})
+		}
+
+		ring = append(ring, ring[0])
+		if hole {
+			ring.Reverse()
+		}
+
+		return ring
+	}
+
+	for i := 0; i < 10; i++ {
+		p := orb.Polygon{
+			star(0, 0, 100, 200, false),
+			star(-20, 0, 10, 30, true),
+			star(20, 0, 10, 30, true),
+		}
+
+		tris := Triangulate(p)
+		if len(tris) == 0 {
+			t.Fatalf("no triangles")
+		}
+
+		checkTriangles(t, p, tris)
+	}
+}
+
+func TestTriangulate_degenerate(t *testing.T) {
+	cases := []struct {
+		name    string
+		polygon orb.Polygon
+	}{
+		{
+			name:    "nil",
+			polygon: nil,
+		},
+		{
+			name:    "empty ring",
+			polygon: orb.Polygon{{}},
+		},
+		{
+			name:    "two points",
+			polygon: orb.Polygon{{{0, 0}, {1, 1}, {0, 0}}},
+		},
+		{
+			name:    "collinear",
+			polygon: orb.Polygon{{{0, 0}, {1, 1}, {2, 2}, {0, 0}}},
+		},
+		{
+			name:    "all the same point",
+			polygon: orb.Polygon{{{1, 1}, {1, 1}, {1, 1}, {1, 1}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tris := Triangulate(tc.polygon)
+			if len(tris) != 0 {
+				t.Errorf("should not have triangles: %v", tris)
+			}
+		})
+	}
+}
+
+func TestLinkedList(t *testing.T) {
+	ring := orb.Ring{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}
+
+	for _, outer := range []bool{true, false} {
+		for _, r := range []orb.Ring{ring, reverse(ring)} {
+			list := linkedList(r, 0, outer)
+
+			// the closing point is removed
+			if l := countNodes(list); l != 4 {
+				t.Errorf("incorrect number of nodes: %v", l)
+			}
+
+			// outer rings are counter-clockwise, holes clockwise
+			if a := listArea(list); (a > 0) != outer {
+				t.Errorf("incorrect orientation for outer %v: %v", outer, a)
+			}
+		}
+	}
+
+	if list := linkedList(nil, 0, true); list != nil {
+		t.Errorf("empty ring should be nil: %v", list)
+	}
+}
+
+func TestFilterPoints(t *testing.T) {
+	// duplicate and collinear points on every edge
+	ring := orb.Ring{
+		{0, 0}, {2, 0}, {4, 0}, {4, 0}, {4, 2},
+		{4, 4}, {0, 4}, {0, 4}, {0, 2}, {0, 0},
+	}
+
+	list := filterPoints(linkedList(ring, 0, true), nil)
+	if l := countNodes(list); l != 4 {
+		t.Errorf("incorrect number of nodes: %v", l)
+	}
+
+	if a := listArea(list); a != 16 {
+		t.Errorf("incorrect area: %v", a)
+	}
+
+	// collapses to a single point
+	list = filterPoints(linkedList(orb.Ring{{0, 0}, {1, 1}, {2, 2}, {0, 0}}, 0, true), nil)
+	if list != list.next {
+		t.Errorf("should be a single node: %v", countNodes(list))
+	}
+
+	// a point hole is not removed
+	list = linkedList(orb.Ring{{1, 1}}, 0, false)
+	list.steiner = true
+	if filterPoints(list, nil) != list {
+		t.Errorf("steiner point should be kept")
+	}
+}
+
+func TestEliminateHoles(t *testing.T) {
+	p := orb.Polygon{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+		{{6, 5}, {6, 8}, {8, 8}, {8, 5}, {6, 5}},
+		{{2, 6}, {2, 7}, {3, 7}, {3, 6}, {2, 6}},
+	}
+
+	ec := &earcut{}
+
+	var start int
+	var outer *earNode
+	var holes []*earNode
+	for i, r := range p {
+		if i == 0 {
+			outer = linkedList(r, start, true)
+		} else {
+			holes = append(holes, linkedList(r, start, false).leftmost())
+		}
+		start += len(r)
+	}
+
+	list := ec.eliminateHoles(holes, outer)
+
+	// every hole is linked in with a bridge there and back
+	if l := countNodes(list); l != 4+3*(4+2) {
+		t.Errorf("incorrect number of nodes: %v", l)
+	}
+
+	// the bridges do not add any area
+	if a := listArea(list); a != 100-4-6-1 {
+		t.Errorf("incorrect area: %v", a)
+	}
+
+	if len(ec.triangles) != 0 {
+		t.Errorf("should not add triangles: %v", ec.triangles)
+	}
+}
+
+func TestSplitEarcut(t *testing.T) {
+	// the fallback when no ears are found, it must work for
+	// any polygon that has a valid diagonal.
+	p := orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {2, 1}, {0, 4}, {0, 0}}}
+
+	ec := &earcut{}
+	ec.splitEarcut(linkedList(p[0], 0, true))
+
+	if len(ec.triangles) != 3*3 {
+		t.Errorf("incorrect number of triangles: %d", len(ec.triangles)/3)
+	}
+
+	checkTriangles(t, p, ec.triangles)
+
+	// a triangle has no diagonal to split on
+	ec = &earcut{}
+	ec.splitEarcut(linkedList(orb.Ring{{0, 0}, {1, 0}, {0, 1}}, 0, true))
+	if len(ec.triangles) != 0 {
+		t.Errorf("should not have triangles: %v", ec.triangles)
+	}
+}
+
+// checkTriangles verifies the triangles are counter-clockwise and cover
+// the area of the polygon.
+func checkTriangles(t testing.TB, p orb.Polygon, tris []int) {
+	t.Helper()
+
+	var points []orb.Point
+	for _, r := range p {
+		points = append(points, r...)
+	}
+
+	area := 0.0
+	for i := 0; i < len(tris); i += 3 {
+		for _, j := range tris[i : i+3] {
+			if j < 0 || j >= len(points) {
+				t.Fatalf("index out of range: %d", j)
+			}
+		}
+
+		a, b, c := points[tris[i]], points[tris[i+1]], points[tris[i+2]]
+		ta := ((b[0]-a[0])*(c[1]-a[1]) - (c[0]-a[0])*(b[1]-a[1])) / 2
+		if ta < 0 {
+			t.Errorf("triangle should be counter-clockwise: %v %v %v", a, b, c)
+		}
+		area += ta
+	}
+
+	expected := 0.0
+	for i, r := range p {
+		if i == 0 {
+			expected += math.Abs(ringArea(r))
+		} else {
+			expected -= math.Abs(ringArea(r))
+		}
+	}
+
+	if math.Abs(area-expected) > 1e-9*expected {
+		t.Errorf("incorrect area: %v != %v", area, expected)
+	}
+}
+
+func ringArea(r orb.Ring) float64 {
+	area := 0.0
+	for i := range r {
+		p, q := r[i], r[(i+1)%len(r)]
+		area += p[0]*q[1] - q[0]*p[1]
+	}
+
+	return area / 2
+}
+
+// listArea returns the signed area of the linked ring,
+// positive if counter-clockwise.
+func listArea(n *earNode) float64 {
+	area := 0.0
+	p := n
+	for {
+		area += p.x*p.next.y - p.next.x*p.y
+
+		p = p.next
+		if p == n {
+			return area / 2
+		}
+	}
+}
+
+func countNodes(n *earNode) int {
+	count := 0
+	p := n
+	for {
+		count++
+
+		p = p.next
+		if p == n {
+			return count
+		}
+	}
+}
+
+func reverse(r orb.Ring) orb.Ring {
+	r = r.Clone()
+	r.Reverse()
+	return r
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 // Length returns the length of the boundary of the geometry
@@ -49,6 +50,10 @@ func Length(g orb.Geometry, df orb.DistanceFunc) float64 {
 		return Length(g.ToRing(), df)
 	}
 
+	if zm.LayoutOf(g) != zm.XY {
+		return Length(zm.ToXY(g), df)
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g))
 }
 
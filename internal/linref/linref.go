@@ -0,0 +1,126 @@
+// Package linref implements linear referencing along line strings
+// independent of how distances and points between points are computed.
+package linref
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// InterpolateFunc returns the point at the fraction of the way from a to b.
+type InterpolateFunc func(a, b orb.Point, fraction float64) orb.Point
+
+// ClosestFunc returns the fraction along the segment [a, b] of the point
+// closest to p and a value that orders how close that point is to p.
+type ClosestFunc func(a, b, p orb.Point) (fraction, dist float64)
+
+// Locate returns the fraction of the length of the line string
+// to the point on the line closest to p.
+func Locate(ls orb.LineString, p orb.Point, df orb.DistanceFunc, cf ClosestFunc) float64 {
+	cum := cumulative(ls, df)
+	if len(cum) == 0 || cum[len(cum)-1] == 0 {
+		return 0
+	}
+
+	min := math.Inf(1)
+	along := 0.0
+	for i := 1; i < len(ls); i++ {
+		t, d := cf(ls[i-1], ls[i], p)
+		if d < min {
+			min = d
+			along = cum[i-1] + t*(cum[i]-cum[i-1])
+		}
+	}
+
+	return along / cum[len(cum)-1]
+}
+
+// Interpolate returns the point at the fraction of the length of the line string.
+// The fraction is clamped to [0, 1].
+func Interpolate(ls orb.LineString, fraction float64, df orb.DistanceFunc, interp InterpolateFunc) orb.Point {
+	if len(ls) == 0 {
+		return orb.Point{}
+	}
+
+	return at(ls, cumulative(ls, df), clamp(fraction), interp)
+}
+
+// Substring returns the part of the line string between the fractions of its length.
+// The fractions are clamped to [0, 1]. If start is greater than end
+// the result is in the reverse direction of the line string.
+func Substring(ls orb.LineString, start, end float64, df orb.DistanceFunc, interp InterpolateFunc) orb.LineString {
+	if len(ls) == 0 {
+		return nil
+	}
+
+	start, end = clamp(start), clamp(end)
+
+	reverse := false
+	if start > end {
+		start, end = end, start
+		reverse = true
+	}
+
+	cum := cumulative(ls, df)
+	total := cum[len(cum)-1]
+
+	result := orb.LineString{at(ls, cum, start, interp)}
+	for i := range ls {
+		if cum[i] > start*total && cum[i] < end*total {
+			result = append(result, ls[i])
+		}
+	}
+	result = append(result, at(ls, cum, end, interp))
+
+	if reverse {
+		result.Reverse()
+	}
+
+	return result
+}
+
+// at returns the point at the fraction of the total length.
+func at(ls orb.LineString, cum []float64, fraction float64, interp InterpolateFunc) orb.Point {
+	target := fraction * cum[len(cum)-1]
+	for i := 1; i < len(ls); i++ {
+		if target >= cum[i] {
+			continue
+		}
+
+		l := cum[i] - cum[i-1]
+		if l == 0 {
+			return ls[i-1]
+		}
+
+		return interp(ls[i-1], ls[i], (target-cum[i-1])/l)
+	}
+
+	return ls[len(ls)-1]
+}
+
+// cumulative returns the distance along the line string to each point.
+func cumulative(ls orb.LineString, df orb.DistanceFunc) []float64 {
+	if len(ls) == 0 {
+		return nil
+	}
+
+	cum := make([]float64, len(ls))
+	for i := 1; i < len(ls); i++ {
+		cum[i] = cum[i-1] + df(ls[i-1], ls[i])
+	}
+
+	return cum
+}
+
+func clamp(f float64) float64 {
+	if f < 0 {
+		return 0
+	}
+
+	if f > 1 {
+		return 1
+	}
+
+	return f
+}
@@ -0,0 +1,117 @@
+// Package segments has the predicates on line segments shared by the
+// planar and validate packages so they agree on when segments touch.
+package segments
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/predicates"
+)
+
+// Orient returns twice the signed area of the triangle a, b, c.
+// It is positive if c is to the left of the line a->b, negative if to
+// the right and zero if the points are collinear.
+func Orient(a, b, c orb.Point) float64 {
+	return predicates.Orient2D(a[0], a[1], b[0], b[1], c[0], c[1])
+}
+
+// SnapTolerance returns the distance under which two points are considered
+// the same when computing segment intersections. It is relative to the
+// magnitude of the coordinates to deal with floating point roundoff.
+func SnapTolerance(points ...orb.Point) float64 {
+	max := 1.0
+	for _, p := range points {
+		max = math.Max(max, math.Max(math.Abs(p[0]), math.Abs(p[1])))
+	}
+
+	return max * 1e-12
+}
+
+// DistanceSquared returns point's squared distance from the segment [a, b].
+func DistanceSquared(a, b, point orb.Point) float64 {
+	x := a[0]
+	y := a[1]
+	dx := b[0] - x
+	dy := b[1] - y
+
+	if dx != 0 || dy != 0 {
+		t := ((point[0]-x)*dx + (point[1]-y)*dy) / (dx*dx + dy*dy)
+
+		if t > 1 {
+			x = b[0]
+			y = b[1]
+		} else if t > 0 {
+			x += dx * t
+			y += dy * t
+		}
+	}
+
+	dx = point[0] - x
+	dy = point[1] - y
+
+	return dx*dx + dy*dy
+}
+
+// Intersection returns the points where the segments [a1, a2] and
+// [b1, b2] intersect. For proper crossings a single point is returned.
+// If the segments are collinear and overlap, the endpoints of the overlap
+// are returned. Endpoints that touch the other segment are always returned
+// exactly so noded edges will share identical vertices.
+func Intersection(a1, a2, b1, b2 orb.Point) []orb.Point {
+	tol := SnapTolerance(a1, a2, b1, b2)
+
+	ab := orb.MultiPoint{a1, a2}.Bound().Pad(tol)
+	bb := orb.MultiPoint{b1, b2}.Bound().Pad(tol)
+	if !ab.Intersects(bb) {
+		return nil
+	}
+
+	// endpoints touching the other segment, this handles shared
+	// vertices, T-junctions and collinear overlaps.
+	var result []orb.Point
+	add := func(p orb.Point) {
+		for _, r := range result {
+			if r == p {
+				return
+			}
+		}
+		result = append(result, p)
+	}
+
+	tol2 := tol * tol
+	if DistanceSquared(b1, b2, a1) <= tol2 {
+		add(a1)
+	}
+	if DistanceSquared(b1, b2, a2) <= tol2 {
+		add(a2)
+	}
+	if DistanceSquared(a1, a2, b1) <= tol2 {
+		add(b1)
+	}
+	if DistanceSquared(a1, a2, b2) <= tol2 {
+		add(b2)
+	}
+
+	if len(result) > 0 {
+		return result
+	}
+
+	d1 := Orient(a1, a2, b1)
+	d2 := Orient(a1, a2, b2)
+	if (d1 > 0 && d2 > 0) || (d1 < 0 && d2 < 0) || d1 == d2 {
+		return nil
+	}
+
+	d3 := Orient(b1, b2, a1)
+	d4 := Orient(b1, b2, a2)
+	if (d3 > 0 && d4 > 0) || (d3 < 0 && d4 < 0) || d3 == d4 {
+		return nil
+	}
+
+	t := d3 / (d3 - d4)
+	return []orb.Point{{
+		a1[0] + t*(a2[0]-a1[0]),
+		a1[1] + t*(a2[1]-a1[1]),
+	}}
+}
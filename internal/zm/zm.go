@@ -0,0 +1,320 @@
+// Package zm converts between the orb geometries with z and/or m values
+// and their raw coordinates. It is shared by the encoding packages so
+// they only need to deal with the coordinates and the layout.
+package zm
+
+import (
+	"github.com/paulmach/orb"
+)
+
+// Layout is the set of values stored for each point.
+type Layout int
+
+// The possible layouts. The values match the ISO WKB type code offsets,
+// e.g. a point with z values has type code 1001.
+const (
+	XY   Layout = 0
+	XYZ  Layout = 1
+	XYM  Layout = 2
+	XYZM Layout = 3
+)
+
+// Size returns the number of values for each point.
+func (l Layout) Size() int {
+	switch l {
+	case XYZ, XYM:
+		return 3
+	case XYZM:
+		return 4
+	}
+
+	return 2
+}
+
+// HasZ returns true if the points have a z value.
+func (l Layout) HasZ() bool {
+	return l == XYZ || l == XYZM
+}
+
+// HasM returns true if the points have a measure value.
+func (l Layout) HasM() bool {
+	return l == XYM || l == XYZM
+}
+
+// Coord is the values of a single point in the order x, y, z, m
+// without the missing values, e.g. x, y, m for XYM.
+type Coord [4]float64
+
+// LayoutOf returns the layout of the geometry.
+// 2d geometries and collections return XY.
+func LayoutOf(g orb.Geometry) Layout {
+	switch g.(type) {
+	case orb.PointZ, orb.MultiPointZ, orb.LineStringZ,
+		orb.MultiLineStringZ, orb.PolygonZ, orb.MultiPolygonZ:
+		return XYZ
+	case orb.PointM, orb.MultiPointM, orb.LineStringM,
+		orb.MultiLineStringM, orb.PolygonM, orb.MultiPolygonM:
+		return XYM
+	case orb.PointZM, orb.MultiPointZM, orb.LineStringZM,
+		orb.MultiLineStringZM, orb.PolygonZM, orb.MultiPolygonZM:
+		return XYZM
+	}
+
+	return XY
+}
+
+// Point returns the point for the coordinate and layout.
+func Point(c Coord, l Layout) orb.Geometry {
+	switch l {
+	case XYZ:
+		return orb.PointZ{c[0], c[1], c[2]}
+	case XYM:
+		return orb.PointM{c[0], c[1], c[2]}
+	case XYZM:
+		return orb.PointZM(c)
+	}
+
+	return orb.Point{c[0], c[1]}
+}
+
+// LineString returns the line string for the coordinates and layout.
+func LineString(cs []Coord, l Layout) orb.Geometry {
+	switch l {
+	case XYZ:
+		ls := make(orb.LineStringZ, 0, len(cs))
+		for _, c := range cs {
+			ls = append(ls, orb.PointZ{c[0], c[1], c[2]})
+		}
+		return ls
+	case XYM:
+		ls := make(orb.LineStringM, 0, len(cs))
+		for _, c := range cs {
+			ls = append(ls, orb.PointM{c[0], c[1], c[2]})
+		}
+		return ls
+	case XYZM:
+		ls := make(orb.LineStringZM, 0, len(cs))
+		for _, c := range cs {
+			ls = append(ls, orb.PointZM(c))
+		}
+		return ls
+	}
+
+	ls := make(orb.LineString, 0, len(cs))
+	for _, c := range cs {
+		ls = append(ls, orb.Point{c[0], c[1]})
+	}
+	return ls
+}
+
+// MultiPoint returns the multi-point for the coordinates and layout.
+func MultiPoint(cs []Coord, l Layout) orb.Geometry {
+	switch ls := LineString(cs, l).(type) {
+	case orb.LineStringZ:
+		return orb.MultiPointZ(ls)
+	case orb.LineStringM:
+		return orb.MultiPointM(ls)
+	case orb.LineStringZM:
+		return orb.MultiPointZM(ls)
+	case orb.LineString:
+		return orb.MultiPoint(ls)
+	}
+
+	panic("unreachable")
+}
+
+// MultiLineString returns the multi-line string for the lines and layout.
+func MultiLineString(lines [][]Coord, l Layout) orb.Geometry {
+	switch l {
+	case XYZ:
+		mls := make(orb.MultiLineStringZ, 0, len(lines))
+		for _, cs := range lines {
+			mls = append(mls, LineString(cs, l).(orb.LineStringZ))
+		}
+		return mls
+	case XYM:
+		mls := make(orb.MultiLineStringM, 0, len(lines))
+		for _, cs := range lines {
+			mls = append(mls, LineString(cs, l).(orb.LineStringM))
+		}
+		return mls
+	case XYZM:
+		mls := make(orb.MultiLineStringZM, 0, len(lines))
+		for _, cs := range lines {
+			mls = append(mls, LineString(cs, l).(orb.LineStringZM))
+		}
+		return mls
+	}
+
+	mls := make(orb.MultiLineString, 0, len(lines))
+	for _, cs := range lines {
+		mls = append(mls, LineString(cs, l).(orb.LineString))
+	}
+	return mls
+}
+
+// Polygon returns the polygon for the rings and layout.
+func Polygon(rings [][]Coord, l Layout) orb.Geometry {
+	switch mls := MultiLineString(rings, l).(type) {
+	case orb.MultiLineStringZ:
+		return orb.PolygonZ(mls)
+	case orb.MultiLineStringM:
+		return orb.PolygonM(mls)
+	case orb.MultiLineStringZM:
+		return orb.PolygonZM(mls)
+	case orb.MultiLineString:
+		p := make(orb.Polygon, 0, len(mls))
+		for _, ls := range mls {
+			p = append(p, orb.Ring(ls))
+		}
+		return p
+	}
+
+	panic("unreachable")
+}
+
+// MultiPolygon returns the multi-polygon for the polygons and layout.
+func MultiPolygon(polygons [][][]Coord, l Layout) orb.Geometry {
+	switch l {
+	case XYZ:
+		mp := make(orb.MultiPolygonZ, 0, len(polygons))
+		for _, rings := range polygons {
+			mp = append(mp, Polygon(rings, l).(orb.PolygonZ))
+		}
+		return mp
+	case XYM:
+		mp := make(orb.MultiPolygonM, 0, len(polygons))
+		for _, rings := range polygons {
+			mp = append(mp, Polygon(rings, l).(orb.PolygonM))
+		}
+		return mp
+	case XYZM:
+		mp := make(orb.MultiPolygonZM, 0, len(polygons))
+		for _, rings := range polygons {
+			mp = append(mp, Polygon(rings, l).(orb.PolygonZM))
+		}
+		return mp
+	}
+
+	mp := make(orb.MultiPolygon, 0, len(polygons))
+	for _, rings := range polygons {
+		mp = append(mp, Polygon(rings, l).(orb.Polygon))
+	}
+	return mp
+}
+
+// Points returns the coordinates of a point, multi-point or line string
+// with z and/or m values. It returns nil for the other types.
+func Points(g orb.Geometry) []Coord {
+	var result []Coord
+	switch g := g.(type) {
+	case orb.PointZ:
+		return []Coord{{g[0], g[1], g[2]}}
+	case orb.PointM:
+		return []Coord{{g[0], g[1], g[2]}}
+	case orb.PointZM:
+		return []Coord{Coord(g)}
+	case orb.MultiPointZ:
+		return Points(orb.LineStringZ(g))
+	case orb.MultiPointM:
+		return Points(orb.LineStringM(g))
+	case orb.MultiPointZM:
+		return Points(orb.LineStringZM(g))
+	case orb.LineStringZ:
+		result = make([]Coord, 0, len(g))
+		for _, p := range g {
+			result = append(result, Coord{p[0], p[1], p[2]})
+		}
+	case orb.LineStringM:
+		result = make([]Coord, 0, len(g))
+		for _, p := range g {
+			result = append(result, Coord{p[0], p[1], p[2]})
+		}
+	case orb.LineStringZM:
+		result = make([]Coord, 0, len(g))
+		for _, p := range g {
+			result = append(result, Coord(p))
+		}
+	}
+
+	return result
+}
+
+// Lines returns the coordinates of a multi-line string or polygon
+// with z and/or m values. It returns nil for the other types.
+func Lines(g orb.Geometry) [][]Coord {
+	var result [][]Coord
+	switch g := g.(type) {
+	case orb.PolygonZ:
+		return Lines(orb.MultiLineStringZ(g))
+	case orb.PolygonM:
+		return Lines(orb.MultiLineStringM(g))
+	case orb.PolygonZM:
+		return Lines(orb.MultiLineStringZM(g))
+	case orb.MultiLineStringZ:
+		result = make([][]Coord, 0, len(g))
+		for _, ls := range g {
+			result = append(result, Points(ls))
+		}
+	case orb.MultiLineStringM:
+		result = make([][]Coord, 0, len(g))
+		for _, ls := range g {
+			result = append(result, Points(ls))
+		}
+	case orb.MultiLineStringZM:
+		result = make([][]Coord, 0, len(g))
+		for _, ls := range g {
+			result = append(result, Points(ls))
+		}
+	}
+
+	return result
+}
+
+// Polygons returns the coordinates of a multi-polygon
+// with z and/or m values. It returns nil for the other types.
+func Polygons(g orb.Geometry) [][][]Coord {
+	var result [][][]Coord
+	switch g := g.(type) {
+	case orb.MultiPolygonZ:
+		result = make([][][]Coord, 0, len(g))
+		for _, p := range g {
+			result = append(result, Lines(p))
+		}
+	case orb.MultiPolygonM:
+		result = make([][][]Coord, 0, len(g))
+		for _, p := range g {
+			result = append(result, Lines(p))
+		}
+	case orb.MultiPolygonZM:
+		result = make([][][]Coord, 0, len(g))
+		for _, p := range g {
+			result = append(result, Lines(p))
+		}
+	}
+
+	return result
+}
+
+// ToXY returns the 2d version of a geometry with z and/or m values.
+// Other geometries are returned as is.
+func ToXY(g orb.Geometry) orb.Geometry {
+	if LayoutOf(g) == XY {
+		return g
+	}
+
+	switch g.GeoJSONType() {
+	case "Point":
+		return Point(Points(g)[0], XY)
+	case "MultiPoint":
+		return MultiPoint(Points(g), XY)
+	case "LineString":
+		return LineString(Points(g), XY)
+	case "MultiLineString":
+		return MultiLineString(Lines(g), XY)
+	case "Polygon":
+		return Polygon(Lines(g), XY)
+	}
+
+	return MultiPolygon(Polygons(g), XY)
+}
@@ -0,0 +1,316 @@
+package orb
+
+// LineStringZ represents a line with a z value, e.g. the elevation for every point.
+type LineStringZ []PointZ
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (ls LineStringZ) GeoJSONType() string {
+	return "LineString"
+}
+
+// Dimensions returns 1 because a LineString is a 1d object.
+func (ls LineStringZ) Dimensions() int {
+	return 1
+}
+
+// Bound returns a bound around the x and y values of the line string.
+func (ls LineStringZ) Bound() Bound {
+	return MultiPointZ(ls).Bound()
+}
+
+// XY returns the 2d line string without the extra values.
+func (ls LineStringZ) XY() LineString {
+	return LineString(MultiPointZ(ls).XY())
+}
+
+// Clone returns a new copy of the line string.
+func (ls LineStringZ) Clone() LineStringZ {
+	return LineStringZ(MultiPointZ(ls).Clone())
+}
+
+// Equal compares two line strings. Returns true if lengths are the same
+// and all points are Equal.
+func (ls LineStringZ) Equal(lineString LineStringZ) bool {
+	return MultiPointZ(ls).Equal(MultiPointZ(lineString))
+}
+
+// MultiLineStringZ is a set of lines with a z value, e.g. the elevation for every point.
+type MultiLineStringZ []LineStringZ
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (mls MultiLineStringZ) GeoJSONType() string {
+	return "MultiLineString"
+}
+
+// Dimensions returns 1 because a MultiLineString is a 1d object.
+func (mls MultiLineStringZ) Dimensions() int {
+	return 1
+}
+
+// Bound returns a bound around the x and y values of all the line strings.
+func (mls MultiLineStringZ) Bound() Bound {
+	if len(mls) == 0 {
+		return emptyBound
+	}
+
+	bound := mls[0].Bound()
+	for i := 1; i < len(mls); i++ {
+		bound = bound.Union(mls[i].Bound())
+	}
+
+	return bound
+}
+
+// XY returns the 2d line strings without the extra values.
+func (mls MultiLineStringZ) XY() MultiLineString {
+	if mls == nil {
+		return nil
+	}
+
+	result := make(MultiLineString, 0, len(mls))
+	for _, ls := range mls {
+		result = append(result, ls.XY())
+	}
+
+	return result
+}
+
+// Clone returns a new deep copy of the multi line string.
+func (mls MultiLineStringZ) Clone() MultiLineStringZ {
+	if mls == nil {
+		return nil
+	}
+
+	result := make(MultiLineStringZ, 0, len(mls))
+	for _, ls := range mls {
+		result = append(result, ls.Clone())
+	}
+
+	return result
+}
+
+// Equal compares two multi line strings. Returns true if lengths are the same
+// and all points are Equal.
+func (mls MultiLineStringZ) Equal(multiLineString MultiLineStringZ) bool {
+	if len(mls) != len(multiLineString) {
+		return false
+	}
+
+	for i := range mls {
+		if !mls[i].Equal(multiLineString[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LineStringM represents a line with a measure value, e.g. a timestamp or distance for every point.
+type LineStringM []PointM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (ls LineStringM) GeoJSONType() string {
+	return "LineString"
+}
+
+// Dimensions returns 1 because a LineString is a 1d object.
+func (ls LineStringM) Dimensions() int {
+	return 1
+}
+
+// Bound returns a bound around the x and y values of the line string.
+func (ls LineStringM) Bound() Bound {
+	return MultiPointM(ls).Bound()
+}
+
+// XY returns the 2d line string without the extra values.
+func (ls LineStringM) XY() LineString {
+	return LineString(MultiPointM(ls).XY())
+}
+
+// Clone returns a new copy of the line string.
+func (ls LineStringM) Clone() LineStringM {
+	return LineStringM(MultiPointM(ls).Clone())
+}
+
+// Equal compares two line strings. Returns true if lengths are the same
+// and all points are Equal.
+func (ls LineStringM) Equal(lineString LineStringM) bool {
+	return MultiPointM(ls).Equal(MultiPointM(lineString))
+}
+
+// MultiLineStringM is a set of lines with a measure value, e.g. a timestamp or distance for every point.
+type MultiLineStringM []LineStringM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (mls MultiLineStringM) GeoJSONType() string {
+	return "MultiLineString"
+}
+
+// Dimensions returns 1 because a MultiLineString is a 1d object.
+func (mls MultiLineStringM) Dimensions() int {
+	return 1
+}
+
+// Bound returns a bound around the x and y values of all the line strings.
+func (mls MultiLineStringM) Bound() Bound {
+	if len(mls) == 0 {
+		return emptyBound
+	}
+
+	bound := mls[0].Bound()
+	for i := 1; i < len(mls); i++ {
+		bound = bound.Union(mls[i].Bound())
+	}
+
+	return bound
+}
+
+// XY returns the 2d line strings without the extra values.
+func (mls MultiLineStringM) XY() MultiLineString {
+	if mls == nil {
+		return nil
+	}
+
+	result := make(MultiLineString, 0, len(mls))
+	for _, ls := range mls {
+		result = append(result, ls.XY())
+	}
+
+	return result
+}
+
+// Clone returns a new deep copy of the multi line string.
+func (mls MultiLineStringM) Clone() MultiLineStringM {
+	if mls == nil {
+		return nil
+	}
+
+	result := make(MultiLineStringM, 0, len(mls))
+	for _, ls := range mls {
+		result = append(result, ls.Clone())
+	}
+
+	return result
+}
+
+// Equal compares two multi line strings. Returns true if lengths are the same
+// and all points are Equal.
+func (mls MultiLineStringM) Equal(multiLineString MultiLineStringM) bool {
+	if len(mls) != len(multiLineString) {
+		return false
+	}
+
+	for i := range mls {
+		if !mls[i].Equal(multiLineString[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// LineStringZM represents a line with both a z and a measure value for every point.
+type LineStringZM []PointZM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (ls LineStringZM) GeoJSONType() string {
+	return "LineString"
+}
+
+// Dimensions returns 1 because a LineString is a 1d object.
+func (ls LineStringZM) Dimensions() int {
+	return 1
+}
+
+// Bound returns a bound around the x and y values of the line string.
+func (ls LineStringZM) Bound() Bound {
+	return MultiPointZM(ls).Bound()
+}
+
+// XY returns the 2d line string without the extra values.
+func (ls LineStringZM) XY() LineString {
+	return LineString(MultiPointZM(ls).XY())
+}
+
+// Clone returns a new copy of the line string.
+func (ls LineStringZM) Clone() LineStringZM {
+	return LineStringZM(MultiPointZM(ls).Clone())
+}
+
+// Equal compares two line strings. Returns true if lengths are the same
+// and all points are Equal.
+func (ls LineStringZM) Equal(lineString LineStringZM) bool {
+	return MultiPointZM(ls).Equal(MultiPointZM(lineString))
+}
+
+// MultiLineStringZM is a set of lines with both a z and a measure value for every point.
+type MultiLineStringZM []LineStringZM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (mls MultiLineStringZM) GeoJSONType() string {
+	return "MultiLineString"
+}
+
+// Dimensions returns 1 because a MultiLineString is a 1d object.
+func (mls MultiLineStringZM) Dimensions() int {
+	return 1
+}
+
+// Bound returns a bound around the x and y values of all the line strings.
+func (mls MultiLineStringZM) Bound() Bound {
+	if len(mls) == 0 {
+		return emptyBound
+	}
+
+	bound := mls[0].Bound()
+	for i := 1; i < len(mls); i++ {
+		bound = bound.Union(mls[i].Bound())
+	}
+
+	return bound
+}
+
+// XY returns the 2d line strings without the extra values.
+func (mls MultiLineStringZM) XY() MultiLineString {
+	if mls == nil {
+		return nil
+	}
+
+	result := make(MultiLineString, 0, len(mls))
+	for _, ls := range mls {
+		result = append(result, ls.XY())
+	}
+
+	return result
+}
+
+// Clone returns a new deep copy of the multi line string.
+func (mls MultiLineStringZM) Clone() MultiLineStringZM {
+	if mls == nil {
+		return nil
+	}
+
+	result := make(MultiLineStringZM, 0, len(mls))
+	for _, ls := range mls {
+		result = append(result, ls.Clone())
+	}
+
+	return result
+}
+
+// Equal compares two multi line strings. Returns true if lengths are the same
+// and all points are Equal.
+func (mls MultiLineStringZM) Equal(multiLineString MultiLineStringZM) bool {
+	if len(mls) != len(multiLineString) {
+		return false
+	}
+
+	for i := range mls {
+		if !mls[i].Equal(multiLineString[i]) {
+			return false
+		}
+	}
+
+	return true
+}
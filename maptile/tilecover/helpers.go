@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+	"github.com/paulmach/orb/internal/zm"
 	"github.com/paulmach/orb/maptile"
 )
 
@@ -35,6 +37,10 @@ func Geometry(g orb.Geometry, z maptile.Zoom) (maptile.Set, error) {
 		return Bound(g, z), nil
 	}
 
+	if zm.LayoutOf(g) != zm.XY {
+		return Geometry(zm.ToXY(g), z)
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g))
 }
 
@@ -73,6 +79,23 @@ func Bound(b orb.Bound, z maptile.Zoom) maptile.Set {
 	return result
 }
 
+// GeoBound creates a tile cover for the geographic bound, which may cross
+// the antimeridian. i.e. all the tiles that intersect the bound on either
+// side of it.
+func GeoBound(b geo.Bound, z maptile.Zoom) maptile.Set {
+	result := make(maptile.Set)
+	for _, part := range b.Bounds() {
+		for t := range Bound(part, z) {
+			// the edge at 180 is the start of tile past the last one
+			if t.Valid() {
+				result[t] = true
+			}
+		}
+	}
+
+	return result
+}
+
 // Collection returns the covering set of tiles for the
 // geoemtry collection.
 func Collection(c orb.Collection, z maptile.Zoom) (maptile.Set, error) {
@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/geo"
+	"github.com/paulmach/orb/maptile"
 )
 
 func TestGeometry(t *testing.T) {
@@ -11,3 +13,23 @@ func TestGeometry(t *testing.T) {
 		Geometry(g, 1)
 	}
 }
+
+func TestGeoBound(t *testing.T) {
+	b := geo.Bound{Min: orb.Point{170, 10}, Max: orb.Point{-170, 20}}
+
+	tiles := GeoBound(b, 2)
+	expected := maptile.Set{
+		maptile.New(3, 1, 2): true,
+		maptile.New(0, 1, 2): true,
+	}
+
+	if len(tiles) != len(expected) {
+		t.Fatalf("incorrect tiles: %v", tiles)
+	}
+
+	for tile := range expected {
+		if !tiles[tile] {
+			t.Errorf("missing tile: %v", tile)
+		}
+	}
+}
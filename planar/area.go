@@ -7,6 +7,7 @@ import (
 	"math"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 // Area returns the area of the geometry in the 2d plane.
@@ -46,6 +47,10 @@ func CentroidArea(g orb.Geometry) (orb.Point, float64) {
 		return CentroidArea(g.ToRing())
 	}
 
+	if zm.LayoutOf(g) != zm.XY {
+		return CentroidArea(zm.ToXY(g))
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g))
 }
 
@@ -0,0 +1,126 @@
+package planar
+
+import (
+	"sort"
+
+	"github.com/paulmach/orb"
+)
+
+// AssemblePolygons groups rings in any order and orientation into polygons.
+// Rings are nested by containment, a ring inside one other ring is a hole,
+// a ring inside a hole is the outer ring of a new polygon and so on.
+// Each hole is added to the smallest ring that contains it. Rings may touch
+// at points but should not cross. Unclosed rings are closed and rings with
+// zero area are dropped. The outer rings are counter-clockwise and the holes
+// clockwise, the rings are reversed if needed. Polygons are in the order
+// of their outer rings in the input.
+func AssemblePolygons(rings []orb.Ring) orb.MultiPolygon {
+	type item struct {
+		ring   orb.Ring
+		area   float64
+		bound  orb.Bound
+		parent int
+		depth  int
+	}
+
+	items := make([]*item, 0, len(rings))
+	for _, r := range rings {
+		if len(r) == 0 {
+			continue
+		}
+
+		if !r.Closed() {
+			r = append(r[:len(r):len(r)], r[0])
+		}
+
+		_, area := ringCentroidArea(r)
+		if area == 0 {
+			continue
+		}
+
+		if area < 0 {
+			area = -area
+		}
+
+		items = append(items, &item{ring: r, area: area, bound: r.Bound(), parent: -1})
+	}
+
+	// the parent is bigger than the ring, so checking the rings
+	// in order of area means the parent will have been placed.
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return items[order[i]].area > items[order[j]].area
+	})
+
+	for k, i := range order {
+		it := items[i]
+
+		// the smallest containing ring is the parent
+		for l := k - 1; l >= 0; l-- {
+			o := items[order[l]]
+			if o.bound.Contains(it.bound.Min) && o.bound.Contains(it.bound.Max) &&
+				ringContainsRing(o.ring, it.ring) {
+				it.parent = order[l]
+				it.depth = o.depth + 1
+				break
+			}
+		}
+	}
+
+	var result orb.MultiPolygon
+	polygon := make([]int, len(items))
+	for i, it := range items {
+		if it.depth%2 == 0 {
+			polygon[i] = len(result)
+			result = append(result, orb.Polygon{withOrientation(it.ring, orb.CCW)})
+		}
+	}
+
+	for _, it := range items {
+		if it.depth%2 == 1 {
+			p := polygon[it.parent]
+			result[p] = append(result[p], withOrientation(it.ring, orb.CW))
+		}
+	}
+
+	return result
+}
+
+// ringContainsRing returns true if the inner ring is inside the outer ring.
+// It assumes the rings do not cross so it uses the first point of the
+// inner ring that is not on the outer ring.
+func ringContainsRing(outer, inner orb.Ring) bool {
+	for _, p := range inner {
+		in, on := ringLocate(outer, p)
+		if !on {
+			return in
+		}
+	}
+
+	// all the vertices touch, e.g. a hole that touches the outer
+	// ring at every vertex. Check the middle of the edges.
+	for i := 1; i < len(inner); i++ {
+		m := orb.Point{(inner[i-1][0] + inner[i][0]) / 2, (inner[i-1][1] + inner[i][1]) / 2}
+		in, on := ringLocate(outer, m)
+		if !on {
+			return in
+		}
+	}
+
+	// the same ring
+	return false
+}
+
+// withOrientation returns the ring, or a reversed copy, with the orientation.
+func withOrientation(r orb.Ring, o orb.Orientation) orb.Ring {
+	if r.Orientation() == o {
+		return r
+	}
+
+	r = r.Clone()
+	r.Reverse()
+	return r
+}
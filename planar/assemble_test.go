@@ -0,0 +1,106 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestAssemblePolygons(t *testing.T) {
+	outer := orb.Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+	hole := orb.Ring{{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}}
+	island := orb.Ring{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}
+	other := orb.Ring{{20, 0}, {21, 0}, {21, 1}, {20, 1}, {20, 0}}
+
+	reverse := func(r orb.Ring) orb.Ring {
+		r = r.Clone()
+		r.Reverse()
+		return r
+	}
+
+	cases := []struct {
+		name   string
+		rings  []orb.Ring
+		result orb.MultiPolygon
+	}{
+		{
+			name:   "empty",
+			rings:  nil,
+			result: nil,
+		},
+		{
+			name:   "single ring",
+			rings:  []orb.Ring{outer},
+			result: orb.MultiPolygon{{outer}},
+		},
+		{
+			name:   "clockwise outer ring",
+			rings:  []orb.Ring{reverse(outer)},
+			result: orb.MultiPolygon{{outer}},
+		},
+		{
+			name:   "hole first",
+			rings:  []orb.Ring{hole, outer},
+			result: orb.MultiPolygon{{outer, hole}},
+		},
+		{
+			name:   "counter-clockwise hole",
+			rings:  []orb.Ring{outer, reverse(hole)},
+			result: orb.MultiPolygon{{outer, hole}},
+		},
+		{
+			name:   "island in hole",
+			rings:  []orb.Ring{island, other, hole, outer},
+			result: orb.MultiPolygon{{island}, {other}, {outer, hole}},
+		},
+		{
+			name:   "unclosed ring",
+			rings:  []orb.Ring{{{0, 0}, {10, 0}, {10, 10}, {0, 10}}},
+			result: orb.MultiPolygon{{outer}},
+		},
+		{
+			name:   "zero area ring",
+			rings:  []orb.Ring{outer, {{1, 1}, {2, 2}, {1, 1}}, {}},
+			result: orb.MultiPolygon{{outer}},
+		},
+		{
+			name: "hole touching the outer ring",
+			rings: []orb.Ring{
+				{{0, 0}, {5, 0}, {5, 5}, {0, 0}},
+				outer,
+			},
+			result: orb.MultiPolygon{{outer, {{0, 0}, {5, 5}, {5, 0}, {0, 0}}}},
+		},
+		{
+			name: "two holes",
+			rings: []orb.Ring{
+				{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+				outer,
+				{{5, 5}, {5, 6}, {6, 6}, {6, 5}, {5, 5}},
+			},
+			result: orb.MultiPolygon{{
+				outer,
+				{{1, 1}, {1, 2}, {2, 2}, {2, 1}, {1, 1}},
+				{{5, 5}, {5, 6}, {6, 6}, {6, 5}, {5, 5}},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := AssemblePolygons(tc.rings)
+			if !result.Equal(tc.result) {
+				t.Errorf("incorrect multi polygon: %v != %v", result, tc.result)
+			}
+		})
+	}
+}
+
+func TestAssemblePolygons_doesNotModify(t *testing.T) {
+	r := orb.Ring{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}
+	AssemblePolygons([]orb.Ring{r})
+
+	if !r.Equal(orb.Ring{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}) {
+		t.Errorf("should not modify the input: %v", r)
+	}
+}
@@ -0,0 +1,61 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+// zigzag returns a line with n segments that are long compared to
+// the size of the extent divided by the number of segments.
+func zigzag(n int, offset orb.Point) orb.LineString {
+	ls := make(orb.LineString, 0, n+1)
+	for i := 0; i <= n; i++ {
+		y := 0.0
+		if i%2 == 1 {
+			y = float64(n)
+		}
+		ls = append(ls, orb.Point{offset[0] + float64(i), offset[1] + y})
+	}
+
+	return ls
+}
+
+// comb returns a polygon with a zigzag top and a flat bottom.
+func comb(n int, offset orb.Point) orb.Polygon {
+	r := orb.Ring(zigzag(n, offset))
+	r = append(r,
+		orb.Point{offset[0] + float64(n), offset[1] - 1},
+		orb.Point{offset[0], offset[1] - 1},
+		offset,
+	)
+
+	return orb.Polygon{r}
+}
+
+func BenchmarkIntersects_longSegments(b *testing.B) {
+	// parallel lines that do not intersect so every pair is checked
+	l1 := zigzag(80000, orb.Point{0, 0})
+	l2 := zigzag(80000, orb.Point{0, 1000})
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if Intersects(l1, l2) {
+			b.Fatalf("should not intersect")
+		}
+	}
+}
+
+func BenchmarkNodeSegments_longSegments(b *testing.B) {
+	// interleaved combs so every tooth crosses its neighbors
+	var segs []segment
+	segs = appendAreaSegments(segs, comb(20000, orb.Point{0, 0}), 0)
+	segs = appendAreaSegments(segs, comb(20000, orb.Point{0.5, 0}), 1)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		nodeSegments(segs)
+	}
+}
@@ -4,24 +4,31 @@ import (
 	"math"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/predicates"
 )
 
 // RingContains returns true if the point is inside the ring.
 // Points on the boundary are considered in.
 func RingContains(r orb.Ring, point orb.Point) bool {
+	in, on := ringLocate(r, point)
+	return in || on
+}
+
+// ringLocate returns if the point is strictly inside the ring or on its boundary.
+func ringLocate(r orb.Ring, point orb.Point) (in, on bool) {
 	if !r.Bound().Contains(point) {
-		return false
+		return false, false
 	}
 
 	c, on := rayIntersect(point, r[0], r[len(r)-1])
 	if on {
-		return true
+		return false, true
 	}
 
 	for i := 0; i < len(r)-1; i++ {
 		inter, on := rayIntersect(point, r[i], r[i+1])
 		if on {
-			return true
+			return false, true
 		}
 
 		if inter {
@@ -29,7 +36,7 @@ func RingContains(r orb.Ring, point orb.Point) bool {
 		}
 	}
 
-	return c
+	return c, false
 }
 
 // PolygonContains checks if the point is within the polygon.
@@ -111,12 +118,12 @@ func rayIntersect(p, s, e orb.Point) (intersects, on bool) {
 		}
 	}
 
-	rs := (p[1] - s[1]) / (p[0] - s[0])
-	ds := (e[1] - s[1]) / (e[0] - s[0])
-
-	if rs == ds {
+	// the ray crosses the segment if p is below the line from s to e,
+	// use the robust orientation so nearly collinear points are consistent.
+	o := predicates.Orient2D(s[0], s[1], e[0], e[1], p[0], p[1])
+	if o == 0 {
 		return false, true
 	}
 
-	return rs <= ds, false
+	return o < 0, false
 }
@@ -1,9 +1,11 @@
 package planar
 
 import (
+	"math"
 	"testing"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/predicates"
 )
 
 func TestRingContains(t *testing.T) {
@@ -117,6 +119,26 @@ func TestRingContains(t *testing.T) {
 	}
 }
 
+func TestRingContains_nearlyCollinear(t *testing.T) {
+	ring := orb.Ring{{0.5, 0.5}, {17.8, 24.4}, {17.8, 0.5}, {0.5, 0.5}}
+
+	// points just above and below the diagonal edge should match
+	// the robust orientation, the naive slope comparison does not.
+	mid := interpolate(ring[0], ring[1], 0.5)
+	ux := math.Nextafter(mid[0], 100) - mid[0]
+	uy := math.Nextafter(mid[1], 100) - mid[1]
+	for i := -16; i <= 16; i++ {
+		for j := -16; j <= 16; j++ {
+			p := orb.Point{mid[0] + float64(i)*ux, mid[1] + float64(j)*uy}
+
+			o := predicates.Orient2D(ring[0][0], ring[0][1], ring[1][0], ring[1][1], p[0], p[1])
+			if v := RingContains(ring, p); v != (o <= 0) {
+				t.Errorf("wrong containment for %v: %v != %v", p, v, o <= 0)
+			}
+		}
+	}
+}
+
 func TestPolygonContains(t *testing.T) {
 	// should exclude holes
 	p := orb.Polygon{
@@ -163,10 +185,3 @@ func TestMultiPolygonContains(t *testing.T) {
 		t.Errorf("should not contain point")
 	}
 }
-
-func interpolate(a, b orb.Point, percent float64) orb.Point {
-	return orb.Point{
-		a[0] + percent*(b[0]-a[0]),
-		a[1] + percent*(b[1]-a[1]),
-	}
-}
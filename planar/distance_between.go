@@ -0,0 +1,131 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// DistanceBetween returns the minimum distance between the geometries
+// and the closest pair of points, the first on a and the second on b.
+// Polygons, rings and bounds are areas, so the distance is zero if one
+// geometry is inside the other. If either geometry is empty the distance
+// is +Inf.
+func DistanceBetween(a, b orb.Geometry) (float64, orb.Point, orb.Point) {
+	if a == nil || b == nil {
+		return math.Inf(1), orb.Point{}, orb.Point{}
+	}
+
+	ca := newComponents(a, 0)
+	cb := newComponents(b, 1)
+	if len(ca.segs) == 0 || len(cb.segs) == 0 {
+		return math.Inf(1), orb.Point{}, orb.Point{}
+	}
+
+	var (
+		found bool
+		point orb.Point
+	)
+
+	segs := append(ca.segs[:len(ca.segs):len(ca.segs)], cb.segs...)
+	forEachIntersectionUntil(segs, func(i, j int, points []orb.Point) bool {
+		if segs[i].tag == segs[j].tag {
+			return true
+		}
+
+		found = true
+		point = points[0]
+		return false
+	})
+
+	if found {
+		return 0, point, point
+	}
+
+	for _, p := range ca.reps {
+		if cb.areaContains(p) {
+			return 0, p, p
+		}
+	}
+
+	for _, p := range cb.reps {
+		if ca.areaContains(p) {
+			return 0, p, p
+		}
+	}
+
+	// The boundaries do not intersect so the closest points are
+	// between the segments.
+	bounds := make([]orb.Bound, len(cb.segs))
+	for i, s := range cb.segs {
+		bounds[i] = s.bound()
+	}
+
+	min := math.Inf(1)
+	var pa, pb orb.Point
+	for _, sa := range ca.segs {
+		ba := sa.bound()
+		for i, sb := range cb.segs {
+			if boundDistanceSquared(ba, bounds[i]) >= min {
+				continue
+			}
+
+			if d, p1, p2 := segmentsClosestPoints(sa.a, sa.b, sb.a, sb.b); d < min {
+				min, pa, pb = d, p1, p2
+			}
+		}
+	}
+
+	return math.Sqrt(min), pa, pb
+}
+
+// segmentsClosestPoints returns the squared distance and the closest points
+// between two segments that do not intersect. The closest pair always
+// includes an endpoint of one of the segments.
+func segmentsClosestPoints(a1, a2, b1, b2 orb.Point) (float64, orb.Point, orb.Point) {
+	min, pa, pb := math.Inf(1), orb.Point{}, orb.Point{}
+
+	for _, p := range [2]orb.Point{a1, a2} {
+		c := segmentClosestPoint(b1, b2, p)
+		if d := DistanceSquared(p, c); d < min {
+			min, pa, pb = d, p, c
+		}
+	}
+
+	for _, p := range [2]orb.Point{b1, b2} {
+		c := segmentClosestPoint(a1, a2, p)
+		if d := DistanceSquared(p, c); d < min {
+			min, pa, pb = d, c, p
+		}
+	}
+
+	return min, pa, pb
+}
+
+// segmentClosestPoint returns the point on the segment [a, b] closest to p.
+func segmentClosestPoint(a, b, p orb.Point) orb.Point {
+	dx := b[0] - a[0]
+	dy := b[1] - a[1]
+	if dx == 0 && dy == 0 {
+		return a
+	}
+
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (dx*dx + dy*dy)
+	if t <= 0 {
+		return a
+	}
+
+	if t >= 1 {
+		return b
+	}
+
+	return orb.Point{a[0] + t*dx, a[1] + t*dy}
+}
+
+// boundDistanceSquared returns the squared distance between two bounds,
+// zero if they overlap.
+func boundDistanceSquared(a, b orb.Bound) float64 {
+	dx := math.Max(0, math.Max(a.Min[0]-b.Max[0], b.Min[0]-a.Max[0]))
+	dy := math.Max(0, math.Max(a.Min[1]-b.Max[1], b.Min[1]-a.Max[1]))
+	return dx*dx + dy*dy
+}
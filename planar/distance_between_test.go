@@ -0,0 +1,155 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestDistanceBetween(t *testing.T) {
+	square := orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}}
+
+	cases := []struct {
+		name     string
+		a, b     orb.Geometry
+		distance float64
+		pa, pb   orb.Point
+	}{
+		{
+			name:     "points",
+			a:        orb.Point{0, 0},
+			b:        orb.Point{3, 4},
+			distance: 5,
+			pa:       orb.Point{0, 0},
+			pb:       orb.Point{3, 4},
+		},
+		{
+			name:     "point to line",
+			a:        orb.Point{1, 2},
+			b:        orb.LineString{{0, 0}, {4, 0}},
+			distance: 2,
+			pa:       orb.Point{1, 2},
+			pb:       orb.Point{1, 0},
+		},
+		{
+			name:     "parallel lines",
+			a:        orb.LineString{{0, 0}, {4, 0}},
+			b:        orb.LineString{{5, 1}, {9, 1}},
+			distance: math.Sqrt2,
+			pa:       orb.Point{4, 0},
+			pb:       orb.Point{5, 1},
+		},
+		{
+			name:     "line end to segment interior",
+			a:        orb.LineString{{2, 1}, {2, 5}},
+			b:        orb.LineString{{0, 0}, {4, 0}},
+			distance: 1,
+			pa:       orb.Point{2, 1},
+			pb:       orb.Point{2, 0},
+		},
+		{
+			name:     "crossing lines",
+			a:        orb.LineString{{0, 0}, {2, 2}},
+			b:        orb.LineString{{0, 2}, {2, 0}},
+			distance: 0,
+			pa:       orb.Point{1, 1},
+			pb:       orb.Point{1, 1},
+		},
+		{
+			name:     "point inside polygon",
+			a:        square,
+			b:        orb.Point{1, 1},
+			distance: 0,
+			pa:       orb.Point{1, 1},
+			pb:       orb.Point{1, 1},
+		},
+		{
+			name:     "line inside polygon",
+			a:        orb.LineString{{1, 1}, {2, 2}},
+			b:        square,
+			distance: 0,
+			pa:       orb.Point{1, 1},
+			pb:       orb.Point{1, 1},
+		},
+		{
+			name:     "point in hole",
+			a:        orb.Polygon{square[0], {{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}}},
+			b:        orb.Point{2, 1.5},
+			distance: 0.5,
+			pa:       orb.Point{2, 1},
+			pb:       orb.Point{2, 1.5},
+		},
+		{
+			name:     "polygon to bound",
+			a:        square,
+			b:        orb.Bound{Min: orb.Point{6, 1}, Max: orb.Point{8, 2}},
+			distance: 2,
+			pa:       orb.Point{4, 1},
+			pb:       orb.Point{6, 1},
+		},
+		{
+			name:     "collection",
+			a:        orb.Collection{orb.Point{10, 10}, orb.Point{-1, 2}},
+			b:        orb.MultiPolygon{square},
+			distance: 1,
+			pa:       orb.Point{-1, 2},
+			pb:       orb.Point{0, 2},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, pa, pb := DistanceBetween(tc.a, tc.b)
+			if math.Abs(d-tc.distance) > 1e-10 {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if !pa.Equal(tc.pa) || !pb.Equal(tc.pb) {
+				t.Errorf("incorrect points: %v %v", pa, pb)
+			}
+
+			// reversing the inputs reverses the points
+			d, pb, pa = DistanceBetween(tc.b, tc.a)
+			if math.Abs(d-tc.distance) > 1e-10 {
+				t.Errorf("reverse: incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if !pa.Equal(tc.pa) || !pb.Equal(tc.pb) {
+				t.Errorf("reverse: incorrect points: %v %v", pa, pb)
+			}
+		})
+	}
+}
+
+func TestDistanceBetween_empty(t *testing.T) {
+	cases := []struct {
+		name string
+		a, b orb.Geometry
+	}{
+		{
+			name: "nil",
+			a:    nil,
+			b:    orb.Point{},
+		},
+		{
+			name: "empty line string",
+			a:    orb.LineString{},
+			b:    orb.Point{},
+		},
+		{
+			name: "empty collection",
+			a:    orb.Point{},
+			b:    orb.Collection{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d, _, _ := DistanceBetween(tc.a, tc.b)
+			if !math.IsInf(d, 1) {
+				t.Errorf("distance should be +Inf: %v", d)
+			}
+		})
+	}
+}
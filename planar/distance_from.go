@@ -5,6 +5,8 @@ import (
 	"math"
 
 	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/segments"
+	"github.com/paulmach/orb/internal/zm"
 )
 
 // DistanceFromSegment returns the point's distance from the segment [a, b].
@@ -14,27 +16,7 @@ func DistanceFromSegment(a, b, point orb.Point) float64 {
 
 // DistanceFromSegmentSquared returns point's squared distance from the segement [a, b].
 func DistanceFromSegmentSquared(a, b, point orb.Point) float64 {
-	x := a[0]
-	y := a[1]
-	dx := b[0] - x
-	dy := b[1] - y
-
-	if dx != 0 || dy != 0 {
-		t := ((point[0]-x)*dx + (point[1]-y)*dy) / (dx*dx + dy*dy)
-
-		if t > 1 {
-			x = b[0]
-			y = b[1]
-		} else if t > 0 {
-			x += dx * t
-			y += dy * t
-		}
-	}
-
-	dx = point[0] - x
-	dy = point[1] - y
-
-	return dx*dx + dy*dy
+	return segments.DistanceSquared(a, b, point)
 }
 
 // DistanceFrom returns the distance from the boundary of the geometry in
@@ -100,6 +82,10 @@ func DistanceFromWithIndex(g orb.Geometry, p orb.Point) (float64, int) {
 		return DistanceFromWithIndex(g.ToRing(), p)
 	}
 
+	if zm.LayoutOf(g) != zm.XY {
+		return DistanceFromWithIndex(zm.ToXY(g), p)
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g))
 }
 
@@ -44,3 +44,157 @@ func ExampleLength() {
 	// Output:
 	// 12
 }
+
+func ExampleUnion() {
+	a := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}}
+	b := orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}}
+
+	mp := planar.Union(a, b)
+
+	fmt.Println(planar.Area(mp))
+	// Output:
+	// 7
+}
+
+func ExampleConvexHull() {
+	mp := orb.MultiPoint{{0, 0}, {2, 0}, {1, 1}, {2, 2}, {0, 2}}
+
+	fmt.Println(planar.ConvexHull(mp))
+	// Output:
+	// [[[0 0] [2 0] [2 2] [0 2] [0 0]]]
+}
+
+func ExampleTriangulate() {
+	p := orb.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}}
+
+	fmt.Println(planar.Triangulate(p))
+	// Output:
+	// [3 0 1 1 2 3]
+}
+
+func ExamplePolylabel() {
+	// a U shape, the centroid is in the gap
+	p := orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {7, 10}, {7, 3}, {3, 3}, {3, 10}, {0, 10}, {0, 0}}}
+
+	c, _ := planar.CentroidArea(p)
+	fmt.Println(planar.PolygonContains(p, c))
+
+	label, _ := planar.Polylabel(p, 0.01)
+	fmt.Println(planar.PolygonContains(p, label))
+	// Output:
+	// false
+	// true
+}
+
+func ExampleDistanceBetween() {
+	a := orb.LineString{{0, 0}, {4, 0}}
+	b := orb.Polygon{{{2, 1}, {3, 1}, {3, 2}, {2, 2}, {2, 1}}}
+
+	d, pa, pb := planar.DistanceBetween(a, b)
+
+	fmt.Println(d, pa, pb)
+	// Output:
+	// 1 [2 0] [2 1]
+}
+
+func ExampleHausdorffDistance() {
+	original := orb.LineString{{0, 0}, {1, 0.5}, {2, 0}, {3, 2}, {4, 0}}
+	simplified := orb.LineString{{0, 0}, {2, 0}, {3, 2}, {4, 0}}
+
+	fmt.Println(planar.HausdorffDistance(original, simplified))
+	// Output:
+	// 0.5
+}
+
+func ExampleLocatePoint() {
+	road := orb.LineString{{0, 0}, {6, 0}, {6, 2}}
+	incident := orb.Point{7, 1}
+
+	f := planar.LocatePoint(road, incident)
+	fmt.Println(f, planar.InterpolatePoint(road, f))
+
+	fmt.Println(planar.Substring(road, 0.5, 1))
+	// Output:
+	// 0.875 [6 1]
+	// [[4 0] [6 0] [6 2]]
+}
+
+func ExampleMinimumRotatedRectangle() {
+	// a building at 45 degrees
+	building := orb.Polygon{{{0, 2}, {2, 0}, {3, 1}, {1, 3}, {0, 2}}}
+
+	rect := planar.MinimumRotatedRectangle(building)
+	fmt.Printf("%.3f %.3f\n", planar.Area(rect), planar.Area(building.Bound()))
+
+	width, _ := planar.MinimumDiameter(building)
+	fmt.Printf("%.3f\n", width)
+
+	center, radius := planar.MinimumEnclosingCircle(building)
+	fmt.Printf("%v %.3f\n", center, radius)
+	// Output:
+	// 4.000 9.000
+	// 1.414
+	// [1.5 1.5] 1.581
+}
+
+func ExampleLineMerge() {
+	ways := orb.MultiLineString{
+		{{2, 0}, {3, 0}},
+		{{0, 0}, {1, 0}},
+		{{2, 0}, {1, 0}},
+	}
+
+	fmt.Println(planar.LineMerge(ways))
+	// Output:
+	// [[[0 0] [1 0] [2 0] [3 0]]]
+}
+
+func ExamplePolygonize() {
+	segments := orb.MultiLineString{
+		{{0, 0}, {1, 0}},
+		{{1, 1}, {1, 0}},
+		{{0, 1}, {1, 1}},
+		{{0, 0}, {0, 1}},
+	}
+
+	fmt.Println(planar.Polygonize(segments))
+	// Output:
+	// [[[[0 0] [1 0] [1 1] [0 1] [0 0]]]]
+}
+
+func ExampleAssemblePolygons() {
+	// rings from a shapefile or OSM relation in any order and orientation
+	rings := []orb.Ring{
+		{{2, 2}, {8, 2}, {8, 8}, {2, 8}, {2, 2}},
+		{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}},
+	}
+
+	fmt.Println(planar.AssemblePolygons(rings))
+	// Output:
+	// [[[[0 0] [10 0] [10 10] [0 10] [0 0]] [[2 2] [2 8] [8 8] [8 2] [2 2]]]]
+}
+
+func ExamplePrecisionModel_Apply() {
+	// a thin sliver that would become a line if rounded on its own
+	mp := orb.MultiPolygon{
+		{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}},
+		{{{4.2, 0}, {4.4, 0}, {4.4, 4}, {4.2, 4}, {4.2, 0}}},
+	}
+
+	pm := planar.FixedPrecision(1)
+	fmt.Println(pm.Apply(mp))
+	// Output:
+	// [[[[0 0] [4 0] [4 4] [0 4] [0 0]]]]
+}
+
+func ExampleSplit() {
+	parcel := orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	road := orb.LineString{{4, -1}, {4, 11}}
+
+	for _, part := range planar.Split(parcel, road) {
+		fmt.Println(planar.Area(part))
+	}
+	// Output:
+	// 40
+	// 60
+}
@@ -0,0 +1,250 @@
+package planar
+
+import (
+	"container/heap"
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/delaunay"
+	"github.com/paulmach/orb/internal/segments"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// ConvexHull returns the smallest convex polygon that contains all
+// the points of the geometry. It uses Andrew's monotone chain algorithm.
+// The ring is counter-clockwise and has no collinear points.
+// If all the points are collinear the ring will have zero area and
+// only contain the endpoints, e.g. {a, b, a}. An empty geometry will
+// return a nil polygon.
+func ConvexHull(g orb.Geometry) orb.Polygon {
+	points := uniquePoints(appendPoints(nil, g))
+	if len(points) == 0 {
+		return nil
+	}
+
+	return orb.Polygon{convexHull(points)}
+}
+
+// convexHull expects the points to be sorted and unique.
+func convexHull(points []orb.Point) orb.Ring {
+	if len(points) == 1 {
+		return orb.Ring{points[0], points[0]}
+	}
+
+	hull := make(orb.Ring, 0, 2*len(points))
+
+	// lower hull
+	for _, p := range points {
+		for len(hull) >= 2 && segments.Orient(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	// upper hull
+	lower := len(hull) + 1
+	for i := len(points) - 2; i >= 0; i-- {
+		p := points[i]
+		for len(hull) >= lower && segments.Orient(hull[len(hull)-2], hull[len(hull)-1], p) <= 0 {
+			hull = hull[:len(hull)-1]
+		}
+		hull = append(hull, p)
+	}
+
+	return hull
+}
+
+// ConcaveHull returns a polygon that contains all the points of the
+// geometry but follows their shape more closely than the convex hull.
+// Starting with the Delaunay triangulation of the points, the triangles
+// with the longest edges on the outside are removed as long as the result
+// stays a single simple polygon with every point on or inside it.
+// The maxEdgeRatio, between 0 and 1, sets the length of the longest allowed
+// outside edge as a fraction between the shortest and longest edges of the
+// triangulation. 1 returns the convex hull, smaller values give more detailed,
+// concave shapes. The result is counter-clockwise.
+func ConcaveHull(g orb.Geometry, maxEdgeRatio float64) orb.Polygon {
+	points := uniquePoints(appendPoints(nil, g))
+	if len(points) == 0 {
+		return nil
+	}
+
+	if maxEdgeRatio >= 1 || len(points) < 4 {
+		return orb.Polygon{convexHull(points)}
+	}
+
+	tri := delaunay.New(points)
+	if len(tri.Triangles) == 0 {
+		return orb.Polygon{convexHull(points)}
+	}
+
+	edgeLength := func(e int) float64 {
+		return Distance(points[tri.Triangles[e]], points[tri.Triangles[delaunay.Next(e)]])
+	}
+
+	minLen, maxLen := math.Inf(1), 0.0
+	for e, o := range tri.Halfedges {
+		if e > o {
+			l := edgeLength(e)
+			minLen = math.Min(minLen, l)
+			maxLen = math.Max(maxLen, l)
+		}
+	}
+	threshold := minLen + math.Max(maxEdgeRatio, 0)*(maxLen-minLen)
+
+	halfedges := append([]int(nil), tri.Halfedges...)
+	removed := make([]bool, len(tri.Triangles)/3)
+	onBoundary := make([]bool, len(points))
+	for _, i := range tri.Hull {
+		onBoundary[i] = true
+	}
+
+	queue := &hullEdgeQueue{}
+	for e, o := range halfedges {
+		if o == -1 {
+			queue.edges = append(queue.edges, hullEdge{e: e, length: edgeLength(e)})
+		}
+	}
+	heap.Init(queue)
+
+	for queue.Len() > 0 {
+		edge := heap.Pop(queue).(hullEdge)
+		if edge.length <= threshold {
+			break
+		}
+
+		// Only triangles with one outside edge can be removed, otherwise a point
+		// would end up outside. If the opposite point is already on the boundary
+		// removing the triangle would split the polygon in two.
+		e1, e2 := delaunay.Next(edge.e), delaunay.Prev(edge.e)
+		if halfedges[e1] == -1 || halfedges[e2] == -1 {
+			continue
+		}
+
+		apex := tri.Triangles[e2]
+		if onBoundary[apex] {
+			continue
+		}
+		onBoundary[apex] = true
+		removed[edge.e/3] = true
+
+		for _, e := range []int{e1, e2} {
+			o := halfedges[e]
+			halfedges[e] = -1
+			halfedges[o] = -1
+			heap.Push(queue, hullEdge{e: o, length: edgeLength(o)})
+		}
+	}
+
+	// The outside edges of the remaining triangles form the ring. The
+	// triangles, and therefore their edges, are counter-clockwise.
+	next := make(map[int]int)
+	for e, o := range halfedges {
+		if o == -1 && !removed[e/3] {
+			next[tri.Triangles[e]] = tri.Triangles[delaunay.Next(e)]
+		}
+	}
+
+	start := tri.Hull[0]
+	ring := make(orb.Ring, 0, len(next)+1)
+	ring = append(ring, points[start])
+	for i := next[start]; i != start; i = next[i] {
+		ring = append(ring, points[i])
+	}
+	ring = append(ring, points[start])
+
+	return orb.Polygon{ring}
+}
+
+type hullEdge struct {
+	e      int
+	length float64
+}
+
+// hullEdgeQueue is a max heap of the outside edges by length.
+type hullEdgeQueue struct {
+	edges []hullEdge
+}
+
+func (q *hullEdgeQueue) Len() int           { return len(q.edges) }
+func (q *hullEdgeQueue) Less(i, j int) bool { return q.edges[i].length > q.edges[j].length }
+func (q *hullEdgeQueue) Swap(i, j int)      { q.edges[i], q.edges[j] = q.edges[j], q.edges[i] }
+func (q *hullEdgeQueue) Push(x interface{}) { q.edges = append(q.edges, x.(hullEdge)) }
+func (q *hullEdgeQueue) Pop() interface{} {
+	e := q.edges[len(q.edges)-1]
+	q.edges = q.edges[:len(q.edges)-1]
+	return e
+}
+
+// appendPoints adds all the vertices of the geometry to the slice.
+func appendPoints(points []orb.Point, g orb.Geometry) []orb.Point {
+	if g == nil {
+		return points
+	}
+
+	switch g := g.(type) {
+	case orb.Point:
+		return append(points, g)
+	case orb.MultiPoint:
+		return append(points, g...)
+	case orb.LineString:
+		return append(points, g...)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			points = append(points, ls...)
+		}
+		return points
+	case orb.Ring:
+		return append(points, g...)
+	case orb.Polygon:
+		for _, r := range g {
+			points = append(points, r...)
+		}
+		return points
+	case orb.MultiPolygon:
+		for _, p := range g {
+			for _, r := range p {
+				points = append(points, r...)
+			}
+		}
+		return points
+	case orb.Collection:
+		for _, c := range g {
+			points = appendPoints(points, c)
+		}
+		return points
+	case orb.Bound:
+		if g.IsEmpty() {
+			return points
+		}
+		return append(points, g.ToRing()[:4]...)
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return appendPoints(points, zm.ToXY(g))
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+// uniquePoints sorts the points by x then y and removes duplicates.
+// This is done in place.
+func uniquePoints(points []orb.Point) []orb.Point {
+	sort.Slice(points, func(i, j int) bool {
+		if points[i][0] != points[j][0] {
+			return points[i][0] < points[j][0]
+		}
+		return points[i][1] < points[j][1]
+	})
+
+	result := points[:0]
+	for i, p := range points {
+		if i == 0 || p != points[i-1] {
+			result = append(result, p)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,131 @@
+package planar
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestConvexHull(t *testing.T) {
+	cases := []struct {
+		name   string
+		geom   orb.Geometry
+		result orb.Polygon
+	}{
+		{
+			name:   "empty",
+			geom:   orb.MultiPoint{},
+			result: nil,
+		},
+		{
+			name:   "single point",
+			geom:   orb.Point{1, 2},
+			result: orb.Polygon{{{1, 2}, {1, 2}}},
+		},
+		{
+			name:   "collinear",
+			geom:   orb.MultiPoint{{1, 1}, {0, 0}, {2, 2}, {1, 1}},
+			result: orb.Polygon{{{0, 0}, {2, 2}, {0, 0}}},
+		},
+		{
+			name:   "square with inner points",
+			geom:   orb.MultiPoint{{0, 0}, {1, 1}, {2, 0}, {2, 2}, {0, 2}, {1, 0}, {0.5, 1.5}},
+			result: orb.Polygon{{{0, 0}, {2, 0}, {2, 2}, {0, 2}, {0, 0}}},
+		},
+		{
+			name:   "concave polygon",
+			geom:   orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {2, 1}, {0, 4}, {0, 0}}},
+			result: orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}},
+		},
+		{
+			name: "collection",
+			geom: orb.Collection{
+				orb.Point{0, 0},
+				orb.LineString{{3, 0}, {1, 1}},
+				orb.Bound{Min: orb.Point{1, 2}, Max: orb.Point{2, 3}},
+			},
+			result: orb.Polygon{{{0, 0}, {3, 0}, {2, 3}, {1, 3}, {0, 0}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := ConvexHull(tc.geom)
+			if !v.Equal(tc.result) {
+				t.Errorf("incorrect hull: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestConcaveHull(t *testing.T) {
+	// random points in a U shape
+	r := rand.New(rand.NewSource(1))
+	var mp orb.MultiPoint
+	for len(mp) < 1000 {
+		p := orb.Point{r.Float64() * 10, r.Float64() * 10}
+		if p[0] > 2 && p[0] < 8 && p[1] > 6 {
+			continue
+		}
+		mp = append(mp, p)
+	}
+
+	convex := ConvexHull(mp)
+	if a := Area(convex); a < 95 {
+		t.Errorf("incorrect convex area: %v", a)
+	}
+
+	concave := ConcaveHull(mp, 0.2)
+	if a := Area(concave); a > 80 {
+		t.Errorf("concave hull should be smaller: %v", a)
+	}
+
+	if o := concave[0].Orientation(); o != orb.CCW {
+		t.Errorf("should be ccw: %v", o)
+	}
+
+	for _, p := range mp {
+		if !PolygonContains(concave, p) {
+			t.Errorf("point not in hull: %v", p)
+		}
+	}
+
+	if v := ConcaveHull(mp, 1); !v.Equal(convex) {
+		t.Errorf("ratio of 1 should be the convex hull: %v", v)
+	}
+}
+
+func TestConcaveHull_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 20; i++ {
+		mp := make(orb.MultiPoint, 0, 200)
+		for j := 0; j < 200; j++ {
+			a := r.Float64() * 2 * math.Pi
+			d := r.Float64() * 10
+			mp = append(mp, orb.Point{d * math.Cos(a) * 2, d * math.Sin(a)})
+		}
+
+		for _, ratio := range []float64{0, 0.1, 0.5} {
+			hull := ConcaveHull(mp, ratio)
+			for _, p := range mp {
+				if !PolygonContains(hull, p) {
+					t.Fatalf("point not in hull: %v", p)
+				}
+			}
+
+			if Area(Union(hull, nil)) != Area(hull) {
+				t.Fatalf("hull is not simple")
+			}
+		}
+	}
+}
+
+func TestHulls_allGeometries(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		ConvexHull(g)
+		ConcaveHull(g, 0.5)
+	}
+}
@@ -0,0 +1,235 @@
+package planar
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/segments"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// Intersects returns true if the geometries share any point.
+// Points on the boundary are considered in, so geometries that only
+// touch will intersect. Polygons, rings and bounds are areas, so a line
+// completely within a polygon intersects it.
+func Intersects(a, b orb.Geometry) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	ab := a.Bound()
+	bb := b.Bound()
+	if ab.IsEmpty() || bb.IsEmpty() || !ab.Pad(segments.SnapTolerance(ab.Min, ab.Max)).Intersects(bb) {
+		return false
+	}
+
+	ca := newComponents(a, 0)
+	cb := newComponents(b, 1)
+
+	found := false
+	segs := append(ca.segs[:len(ca.segs):len(ca.segs)], cb.segs...)
+	forEachIntersectionUntil(segs, func(i, j int, points []orb.Point) bool {
+		if segs[i].tag == segs[j].tag {
+			return true
+		}
+
+		found = true
+		return false
+	})
+
+	if found {
+		return true
+	}
+
+	// No boundaries intersect so every connected part is either
+	// completely inside or outside the areas of the other geometry.
+	for _, p := range ca.reps {
+		if cb.areaContains(p) {
+			return true
+		}
+	}
+
+	for _, p := range cb.reps {
+		if ca.areaContains(p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Intersections returns the points where the geometries intersect.
+// These are the points where lines and the boundaries of areas cross or touch,
+// the endpoints of overlapping sections, and any points of one geometry that
+// lie on the other. The boundaries of polygons, rings and bounds are used,
+// so a line completely within a polygon will return no points.
+// The result is sorted by x then y and has no duplicates.
+func Intersections(a, b orb.Geometry) []orb.Point {
+	if a == nil || b == nil {
+		return nil
+	}
+
+	ab := a.Bound()
+	bb := b.Bound()
+	if ab.IsEmpty() || bb.IsEmpty() || !ab.Pad(segments.SnapTolerance(ab.Min, ab.Max)).Intersects(bb) {
+		return nil
+	}
+
+	ca := newComponents(a, 0)
+	cb := newComponents(b, 1)
+
+	var result []orb.Point
+	seen := make(map[orb.Point]bool)
+	add := func(p orb.Point) {
+		if !seen[p] {
+			seen[p] = true
+			result = append(result, p)
+		}
+	}
+
+	segs := append(ca.segs[:len(ca.segs):len(ca.segs)], cb.segs...)
+	forEachIntersection(segs, func(i, j int, points []orb.Point) {
+		if segs[i].tag == segs[j].tag {
+			return
+		}
+
+		for _, p := range points {
+			add(p)
+		}
+	})
+
+	for _, p := range ca.points {
+		if cb.areaContains(p) {
+			add(p)
+		}
+	}
+
+	for _, p := range cb.points {
+		if ca.areaContains(p) {
+			add(p)
+		}
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i][0] != result[j][0] {
+			return result[i][0] < result[j][0]
+		}
+		return result[i][1] < result[j][1]
+	})
+
+	return result
+}
+
+// components is a geometry broken down into the parts needed
+// to compute intersections. Points are included in the segments as
+// zero length segments so they are part of the sweep.
+type components struct {
+	points []orb.Point
+	segs   []segment
+	areas  []orb.Polygon
+
+	// reps has one point for each connected part of the geometry.
+	reps []orb.Point
+}
+
+func newComponents(g orb.Geometry, tag int) *components {
+	c := &components{}
+	c.add(g, tag)
+	return c
+}
+
+func (c *components) add(g orb.Geometry, tag int) {
+	if g == nil {
+		return
+	}
+
+	switch g := g.(type) {
+	case orb.Point:
+		c.addPoint(g, tag)
+	case orb.MultiPoint:
+		for _, p := range g {
+			c.addPoint(p, tag)
+		}
+	case orb.LineString:
+		c.addLine(g, tag)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			c.addLine(ls, tag)
+		}
+	case orb.Ring:
+		c.addPolygon(orb.Polygon{g}, tag)
+	case orb.Polygon:
+		c.addPolygon(g, tag)
+	case orb.MultiPolygon:
+		for _, p := range g {
+			c.addPolygon(p, tag)
+		}
+	case orb.Collection:
+		for _, sub := range g {
+			c.add(sub, tag)
+		}
+	case orb.Bound:
+		if !g.IsEmpty() {
+			c.addPolygon(g.ToPolygon(), tag)
+		}
+	default:
+		if zm.LayoutOf(g) != zm.XY {
+			c.add(zm.ToXY(g), tag)
+			return
+		}
+
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+func (c *components) addPoint(p orb.Point, tag int) {
+	c.points = append(c.points, p)
+	c.reps = append(c.reps, p)
+	c.segs = append(c.segs, segment{a: p, b: p, tag: tag})
+}
+
+func (c *components) addLine(ls orb.LineString, tag int) {
+	if len(ls) == 0 {
+		return
+	}
+
+	if len(ls) == 1 {
+		c.addPoint(ls[0], tag)
+		return
+	}
+
+	c.reps = append(c.reps, ls[0])
+	for i := 0; i < len(ls)-1; i++ {
+		c.segs = append(c.segs, segment{a: ls[i], b: ls[i+1], tag: tag})
+	}
+}
+
+func (c *components) addPolygon(p orb.Polygon, tag int) {
+	if len(p) == 0 || len(p[0]) == 0 {
+		return
+	}
+
+	c.areas = append(c.areas, p)
+	for _, r := range p {
+		if len(r) == 0 {
+			continue
+		}
+
+		c.addLine(orb.LineString(r), tag)
+		if r[0] != r[len(r)-1] {
+			c.segs = append(c.segs, segment{a: r[len(r)-1], b: r[0], tag: tag})
+		}
+	}
+}
+
+// areaContains returns true if the point is within one of the areas.
+func (c *components) areaContains(p orb.Point) bool {
+	for _, poly := range c.areas {
+		if PolygonContains(poly, p) {
+			return true
+		}
+	}
+
+	return false
+}
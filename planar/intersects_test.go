@@ -0,0 +1,208 @@
+package planar
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestIntersects(t *testing.T) {
+	square := orb.Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+		{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+	}
+
+	cases := []struct {
+		name   string
+		a, b   orb.Geometry
+		result bool
+	}{
+		{
+			name:   "same point",
+			a:      orb.Point{1, 2},
+			b:      orb.Point{1, 2},
+			result: true,
+		},
+		{
+			name:   "different point",
+			a:      orb.Point{1, 2},
+			b:      orb.Point{2, 1},
+			result: false,
+		},
+		{
+			name:   "point on line",
+			a:      orb.Point{1, 1},
+			b:      orb.LineString{{0, 0}, {2, 2}},
+			result: true,
+		},
+		{
+			name:   "point off line",
+			a:      orb.Point{1, 1.5},
+			b:      orb.LineString{{0, 0}, {2, 2}},
+			result: false,
+		},
+		{
+			name:   "crossing lines",
+			a:      orb.LineString{{0, 0}, {2, 2}},
+			b:      orb.LineString{{0, 2}, {2, 0}},
+			result: true,
+		},
+		{
+			name:   "parallel lines",
+			a:      orb.LineString{{0, 0}, {2, 2}},
+			b:      orb.LineString{{0, 1}, {2, 3}},
+			result: false,
+		},
+		{
+			name:   "lines touching at end",
+			a:      orb.LineString{{0, 0}, {2, 2}},
+			b:      orb.LineString{{2, 2}, {3, 0}},
+			result: true,
+		},
+		{
+			name:   "line within polygon",
+			a:      orb.LineString{{0.5, 0.5}, {3.5, 0.5}},
+			b:      square,
+			result: true,
+		},
+		{
+			name:   "line within hole",
+			a:      orb.LineString{{1.5, 1.5}, {2.5, 2.5}},
+			b:      square,
+			result: false,
+		},
+		{
+			name:   "point in hole",
+			a:      square,
+			b:      orb.MultiPoint{{2, 2}, {5, 5}},
+			result: false,
+		},
+		{
+			name:   "point on hole boundary",
+			a:      square,
+			b:      orb.MultiPoint{{2, 2}, {1, 2}},
+			result: true,
+		},
+		{
+			name:   "polygon in polygon",
+			a:      orb.Bound{Min: orb.Point{0.25, 0.25}, Max: orb.Point{0.5, 0.5}},
+			b:      square,
+			result: true,
+		},
+		{
+			name:   "polygon in hole",
+			a:      orb.Bound{Min: orb.Point{1.5, 1.5}, Max: orb.Point{2.5, 2.5}},
+			b:      square,
+			result: false,
+		},
+		{
+			name:   "polygon around polygon",
+			a:      orb.Ring{{-1, -1}, {5, -1}, {5, 5}, {-1, 5}, {-1, -1}},
+			b:      square,
+			result: true,
+		},
+		{
+			name:   "collection",
+			a:      orb.Collection{orb.Point{10, 10}, orb.LineString{{0, 0}, {0, 1}}},
+			b:      orb.Collection{orb.Point{0, 0.5}},
+			result: true,
+		},
+		{
+			name:   "empty",
+			a:      orb.LineString{},
+			b:      square,
+			result: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := Intersects(tc.a, tc.b); v != tc.result {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+
+			if v := Intersects(tc.b, tc.a); v != tc.result {
+				t.Errorf("incorrect reverse result: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestIntersections(t *testing.T) {
+	cases := []struct {
+		name   string
+		a, b   orb.Geometry
+		result []orb.Point
+	}{
+		{
+			name:   "crossing lines",
+			a:      orb.LineString{{0, 0}, {2, 2}},
+			b:      orb.LineString{{0, 2}, {2, 0}},
+			result: []orb.Point{{1, 1}},
+		},
+		{
+			name:   "zig zag",
+			a:      orb.LineString{{0, 0}, {4, 0}},
+			b:      orb.LineString{{0, 1}, {1, -1}, {2, 1}, {3, -1}},
+			result: []orb.Point{{0.5, 0}, {1.5, 0}, {2.5, 0}},
+		},
+		{
+			name:   "overlapping lines",
+			a:      orb.LineString{{0, 0}, {3, 0}},
+			b:      orb.LineString{{1, 0}, {5, 0}},
+			result: []orb.Point{{1, 0}, {3, 0}},
+		},
+		{
+			name:   "line through polygon",
+			a:      orb.LineString{{-1, 1}, {3, 1}},
+			b:      orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			result: []orb.Point{{0, 1}, {2, 1}},
+		},
+		{
+			name:   "line within polygon",
+			a:      orb.LineString{{0.5, 1}, {1.5, 1}},
+			b:      orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			result: nil,
+		},
+		{
+			name:   "points in polygon",
+			a:      orb.MultiPoint{{1, 1}, {3, 3}, {2, 1}},
+			b:      orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			result: []orb.Point{{1, 1}, {2, 1}},
+		},
+		{
+			name:   "squares",
+			a:      orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			b:      orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}},
+			result: []orb.Point{{1, 2}, {2, 1}},
+		},
+		{
+			name:   "disjoint",
+			a:      orb.LineString{{0, 0}, {1, 0}},
+			b:      orb.LineString{{0, 1}, {1, 1}},
+			result: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := Intersections(tc.a, tc.b); !reflect.DeepEqual(v, tc.result) {
+				t.Errorf("incorrect result: %v != %v", v, tc.result)
+			}
+
+			if v := Intersections(tc.b, tc.a); !reflect.DeepEqual(v, tc.result) {
+				t.Errorf("incorrect reverse result: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestIntersects_allGeometries(t *testing.T) {
+	for _, a := range orb.AllGeometries {
+		for _, b := range orb.AllGeometries {
+			Intersects(a, b)
+			Intersections(a, b)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package planar
+
+import (
+	"github.com/paulmach/orb"
+)
+
+// LineMerge joins the lines that share endpoints into the longest possible
+// chains. Lines are only joined at points where exactly two line ends meet,
+// so the result breaks at every junction. Lines are reversed where needed but
+// keep their direction when possible. Closed loops are returned starting at
+// the first point of the first line in the loop. Lines with less than two
+// points are returned unchanged.
+func LineMerge(mls orb.MultiLineString) orb.MultiLineString {
+	ends := make(map[orb.Point][]int, 2*len(mls))
+	for i, ls := range mls {
+		if len(ls) < 2 {
+			continue
+		}
+
+		ends[ls[0]] = append(ends[ls[0]], i)
+		ends[ls[len(ls)-1]] = append(ends[ls[len(ls)-1]], i)
+	}
+
+	used := make([]bool, len(mls))
+
+	// chain follows the lines through the points where only two line
+	// ends meet, starting with the line in the given direction.
+	chain := func(i int, reverse bool) orb.LineString {
+		used[i] = true
+
+		result := append(orb.LineString(nil), mls[i]...)
+		if reverse {
+			result.Reverse()
+		}
+
+		for {
+			p := result[len(result)-1]
+			if len(ends[p]) != 2 {
+				return result
+			}
+
+			next := -1
+			for _, j := range ends[p] {
+				if !used[j] {
+					next = j
+				}
+			}
+
+			if next == -1 {
+				return result
+			}
+			used[next] = true
+
+			ls := mls[next]
+			if ls[0] == p {
+				result = append(result, ls[1:]...)
+			} else {
+				for k := len(ls) - 2; k >= 0; k-- {
+					result = append(result, ls[k])
+				}
+			}
+		}
+	}
+
+	var result orb.MultiLineString
+
+	// start chains at the ends and junctions, in the direction of the
+	// first line if possible.
+	for i, ls := range mls {
+		if !used[i] && len(ls) >= 2 && len(ends[ls[0]]) != 2 {
+			result = append(result, chain(i, false))
+		}
+	}
+
+	for i, ls := range mls {
+		if !used[i] && len(ls) >= 2 && len(ends[ls[len(ls)-1]]) != 2 {
+			result = append(result, chain(i, true))
+		}
+	}
+
+	// everything left is part of a closed loop
+	for i, ls := range mls {
+		if used[i] {
+			continue
+		}
+
+		if len(ls) < 2 {
+			result = append(result, ls)
+			continue
+		}
+
+		result = append(result, chain(i, false))
+	}
+
+	return result
+}
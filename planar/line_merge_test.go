@@ -0,0 +1,72 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestLineMerge(t *testing.T) {
+	cases := []struct {
+		name   string
+		lines  orb.MultiLineString
+		result orb.MultiLineString
+	}{
+		{
+			name:   "empty",
+			lines:  orb.MultiLineString{},
+			result: nil,
+		},
+		{
+			name:   "in order",
+			lines:  orb.MultiLineString{{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}, {{2, 0}, {3, 0}}},
+			result: orb.MultiLineString{{{0, 0}, {1, 0}, {2, 0}, {3, 0}}},
+		},
+		{
+			name:   "out of order",
+			lines:  orb.MultiLineString{{{2, 0}, {3, 0}}, {{0, 0}, {1, 0}}, {{1, 0}, {2, 0}}},
+			result: orb.MultiLineString{{{0, 0}, {1, 0}, {2, 0}, {3, 0}}},
+		},
+		{
+			name:   "reversed line",
+			lines:  orb.MultiLineString{{{0, 0}, {1, 0}}, {{2, 0}, {1, 0}}},
+			result: orb.MultiLineString{{{0, 0}, {1, 0}, {2, 0}}},
+		},
+		{
+			name:   "all reversed",
+			lines:  orb.MultiLineString{{{1, 0}, {0, 0}}, {{2, 0}, {1, 0}}},
+			result: orb.MultiLineString{{{2, 0}, {1, 0}, {0, 0}}},
+		},
+		{
+			name: "junction",
+			lines: orb.MultiLineString{
+				{{0, 0}, {1, 0}}, {{1, 0}, {2, 0}},
+				{{2, 0}, {3, 0}}, {{2, 0}, {2, 1}}, {{2, 1}, {2, 2}},
+			},
+			result: orb.MultiLineString{
+				{{0, 0}, {1, 0}, {2, 0}},
+				{{2, 0}, {3, 0}},
+				{{2, 0}, {2, 1}, {2, 2}},
+			},
+		},
+		{
+			name:   "loop",
+			lines:  orb.MultiLineString{{{0, 0}, {1, 0}}, {{1, 1}, {0, 0}}, {{1, 0}, {1, 1}}},
+			result: orb.MultiLineString{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}},
+		},
+		{
+			name:   "short lines",
+			lines:  orb.MultiLineString{{{0, 0}, {1, 0}}, {{5, 5}}, {}, {{1, 0}, {2, 0}}},
+			result: orb.MultiLineString{{{0, 0}, {1, 0}, {2, 0}}, {{5, 5}}, {}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := LineMerge(tc.lines)
+			if !result.Equal(tc.result) {
+				t.Errorf("incorrect lines: %v != %v", result, tc.result)
+			}
+		})
+	}
+}
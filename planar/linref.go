@@ -0,0 +1,55 @@
+package planar
+
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/linref"
+)
+
+// LocatePoint returns the fraction of the length of the line string, from
+// 0 to 1, to the point on the line closest to p. Multiply by the Length of
+// the line for the distance along it. Empty and zero length lines return 0.
+func LocatePoint(ls orb.LineString, p orb.Point) float64 {
+	return linref.Locate(ls, p, Distance, closestOnSegment)
+}
+
+// InterpolatePoint returns the point at the fraction of the length
+// of the line string. The fraction is clamped to [0, 1].
+// Empty lines return the zero point.
+func InterpolatePoint(ls orb.LineString, fraction float64) orb.Point {
+	return linref.Interpolate(ls, fraction, Distance, interpolate)
+}
+
+// Substring returns the part of the line string between the two fractions
+// of its length. The fractions are clamped to [0, 1]. If start is greater
+// than end the result is reversed, from start to end. The result always
+// has at least two points, these will be the same if start equals end.
+func Substring(ls orb.LineString, start, end float64) orb.LineString {
+	return linref.Substring(ls, start, end, Distance, interpolate)
+}
+
+// closestOnSegment returns the fraction along the segment [a, b] of the
+// point closest to p and the squared distance to that point.
+func closestOnSegment(a, b, p orb.Point) (float64, float64) {
+	dx := b[0] - a[0]
+	dy := b[1] - a[1]
+	if dx == 0 && dy == 0 {
+		return 0, DistanceSquared(a, p)
+	}
+
+	t := ((p[0]-a[0])*dx + (p[1]-a[1])*dy) / (dx*dx + dy*dy)
+	if t < 0 {
+		t = 0
+	} else if t > 1 {
+		t = 1
+	}
+
+	return t, DistanceSquared(orb.Point{a[0] + t*dx, a[1] + t*dy}, p)
+}
+
+// interpolate returns the point at the fraction of the way from a to b.
+func interpolate(a, b orb.Point, percent float64) orb.Point {
+	return orb.Point{
+		a[0] + percent*(b[0]-a[0]),
+		a[1] + percent*(b[1]-a[1]),
+	}
+}
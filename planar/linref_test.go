@@ -0,0 +1,224 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestLocatePoint(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {6, 0}, {6, 2}}
+
+	cases := []struct {
+		name     string
+		ls       orb.LineString
+		point    orb.Point
+		fraction float64
+	}{
+		{
+			name:     "on the line",
+			ls:       ls,
+			point:    orb.Point{3, 0},
+			fraction: 0.375,
+		},
+		{
+			name:     "off the line",
+			ls:       ls,
+			point:    orb.Point{7, 1},
+			fraction: 0.875,
+		},
+		{
+			name:     "before start",
+			ls:       ls,
+			point:    orb.Point{-1, -1},
+			fraction: 0,
+		},
+		{
+			name:     "after end",
+			ls:       ls,
+			point:    orb.Point{6, 5},
+			fraction: 1,
+		},
+		{
+			name:     "zero length",
+			ls:       orb.LineString{{1, 1}, {1, 1}},
+			point:    orb.Point{6, 5},
+			fraction: 0,
+		},
+		{
+			name:     "empty",
+			ls:       orb.LineString{},
+			point:    orb.Point{6, 5},
+			fraction: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if f := LocatePoint(tc.ls, tc.point); f != tc.fraction {
+				t.Errorf("incorrect fraction: %v != %v", f, tc.fraction)
+			}
+		})
+	}
+}
+
+func TestInterpolatePoint(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {6, 0}, {6, 2}}
+
+	cases := []struct {
+		name     string
+		ls       orb.LineString
+		fraction float64
+		point    orb.Point
+	}{
+		{
+			name:     "start",
+			ls:       ls,
+			fraction: 0,
+			point:    orb.Point{0, 0},
+		},
+		{
+			name:     "first segment",
+			ls:       ls,
+			fraction: 0.5,
+			point:    orb.Point{4, 0},
+		},
+		{
+			name:     "vertex",
+			ls:       ls,
+			fraction: 0.75,
+			point:    orb.Point{6, 0},
+		},
+		{
+			name:     "second segment",
+			ls:       ls,
+			fraction: 0.875,
+			point:    orb.Point{6, 1},
+		},
+		{
+			name:     "end",
+			ls:       ls,
+			fraction: 1,
+			point:    orb.Point{6, 2},
+		},
+		{
+			name:     "clamped",
+			ls:       ls,
+			fraction: -1,
+			point:    orb.Point{0, 0},
+		},
+		{
+			name:     "duplicate points",
+			ls:       orb.LineString{{0, 0}, {0, 0}, {2, 0}, {2, 0}},
+			fraction: 0.5,
+			point:    orb.Point{1, 0},
+		},
+		{
+			name:     "single point",
+			ls:       orb.LineString{{1, 2}},
+			fraction: 0.5,
+			point:    orb.Point{1, 2},
+		},
+		{
+			name:     "empty",
+			ls:       orb.LineString{},
+			fraction: 0.5,
+			point:    orb.Point{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if p := InterpolatePoint(tc.ls, tc.fraction); !p.Equal(tc.point) {
+				t.Errorf("incorrect point: %v != %v", p, tc.point)
+			}
+		})
+	}
+}
+
+func TestSubstring(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {6, 0}, {6, 2}}
+
+	cases := []struct {
+		name       string
+		ls         orb.LineString
+		start, end float64
+		result     orb.LineString
+	}{
+		{
+			name:   "whole line",
+			ls:     ls,
+			start:  0,
+			end:    1,
+			result: ls,
+		},
+		{
+			name:   "within a segment",
+			ls:     ls,
+			start:  0.25,
+			end:    0.5,
+			result: orb.LineString{{2, 0}, {4, 0}},
+		},
+		{
+			name:   "across a vertex",
+			ls:     ls,
+			start:  0.5,
+			end:    0.875,
+			result: orb.LineString{{4, 0}, {6, 0}, {6, 1}},
+		},
+		{
+			name:   "starting at a vertex",
+			ls:     ls,
+			start:  0.75,
+			end:    1,
+			result: orb.LineString{{6, 0}, {6, 2}},
+		},
+		{
+			name:   "reversed",
+			ls:     ls,
+			start:  0.875,
+			end:    0.5,
+			result: orb.LineString{{6, 1}, {6, 0}, {4, 0}},
+		},
+		{
+			name:   "same start and end",
+			ls:     ls,
+			start:  0.5,
+			end:    0.5,
+			result: orb.LineString{{4, 0}, {4, 0}},
+		},
+		{
+			name:   "clamped",
+			ls:     ls,
+			start:  -1,
+			end:    2,
+			result: ls,
+		},
+		{
+			name:   "empty",
+			ls:     orb.LineString{},
+			start:  0,
+			end:    1,
+			result: nil,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if s := Substring(tc.ls, tc.start, tc.end); !s.Equal(tc.result) {
+				t.Errorf("incorrect substring: %v != %v", s, tc.result)
+			}
+		})
+	}
+}
+
+func TestLocatePoint_roundTrip(t *testing.T) {
+	ls := orb.LineString{{0, 0}, {3, 4}, {10, 4}, {10, -3}}
+	for _, f := range []float64{0, 0.1, 0.25, 0.5, 0.75, 0.99, 1} {
+		p := InterpolatePoint(ls, f)
+		if v := LocatePoint(ls, p); math.Abs(v-f) > 1e-12 {
+			t.Errorf("incorrect round trip: %v != %v", v, f)
+		}
+	}
+}
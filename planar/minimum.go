@@ -0,0 +1,218 @@
+package planar
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/paulmach/orb"
+)
+
+// MinimumRotatedRectangle returns the smallest area rectangle, at any
+// angle, that contains all the points of the geometry. It uses rotating
+// calipers on the convex hull since one side of the rectangle will be
+// collinear with an edge of the hull. The ring is counter-clockwise.
+// If all the points are collinear the convex hull, a ring of zero area,
+// is returned. An empty geometry will return a nil polygon.
+func MinimumRotatedRectangle(g orb.Geometry) orb.Polygon {
+	points := uniquePoints(appendPoints(nil, g))
+	if len(points) == 0 {
+		return nil
+	}
+
+	hull := convexHull(points)
+	if len(hull) < 4 {
+		return orb.Polygon{hull}
+	}
+
+	var result orb.Ring
+	min := math.Inf(1)
+	rotatingCalipers(hull, func(c caliper) {
+		if area := c.height * (c.max - c.min); area < min {
+			min = area
+			p0 := c.at(c.min, 0)
+			result = orb.Ring{p0, c.at(c.max, 0), c.at(c.max, c.height), c.at(c.min, c.height), p0}
+		}
+	})
+
+	return orb.Polygon{result}
+}
+
+// MinimumDiameter returns the minimum width of the geometry and a line
+// across it of that length. The width is the smallest distance between two
+// parallel lines that contain all the points, e.g. the smallest gap a shape
+// fits through. If all the points are collinear the width is zero. An empty
+// geometry will return zero and a nil line string.
+func MinimumDiameter(g orb.Geometry) (float64, orb.LineString) {
+	points := uniquePoints(appendPoints(nil, g))
+	if len(points) == 0 {
+		return 0, nil
+	}
+
+	hull := convexHull(points)
+	if len(hull) < 4 {
+		return 0, orb.LineString{hull[0], hull[0]}
+	}
+
+	var result orb.LineString
+	min := math.Inf(1)
+	rotatingCalipers(hull, func(c caliper) {
+		if c.height < min {
+			min = c.height
+			p := hull[c.far]
+			result = orb.LineString{p, {p[0] - c.height*c.v[0], p[1] - c.height*c.v[1]}}
+		}
+	})
+
+	return min, result
+}
+
+// MinimumEnclosingCircle returns the center and radius of the smallest
+// circle that contains all the points of the geometry. It uses Welzl's
+// algorithm on the points of the convex hull. An empty geometry will
+// return the zero point and radius.
+func MinimumEnclosingCircle(g orb.Geometry) (orb.Point, float64) {
+	points := uniquePoints(appendPoints(nil, g))
+	if len(points) == 0 {
+		return orb.Point{}, 0
+	}
+
+	hull := convexHull(points)
+	hull = hull[:len(hull)-1]
+
+	// a random order makes the expected running time linear,
+	// use a fixed seed so the result is repeatable
+	r := rand.New(rand.NewSource(int64(len(hull))))
+	r.Shuffle(len(hull), func(i, j int) { hull[i], hull[j] = hull[j], hull[i] })
+
+	center, radius := hull[0], 0.0
+	inside := func(p orb.Point) bool {
+		return Distance(center, p) <= radius*(1+1e-12)
+	}
+
+	for i := 1; i < len(hull); i++ {
+		if inside(hull[i]) {
+			continue
+		}
+
+		center, radius = hull[i], 0
+		for j := 0; j < i; j++ {
+			if inside(hull[j]) {
+				continue
+			}
+
+			center, radius = diameterCircle(hull[i], hull[j])
+			for k := 0; k < j; k++ {
+				if !inside(hull[k]) {
+					center, radius = circumcircle(hull[i], hull[j], hull[k])
+				}
+			}
+		}
+	}
+
+	return center, radius
+}
+
+// diameterCircle returns the circle with the segment [a, b] as its diameter.
+func diameterCircle(a, b orb.Point) (orb.Point, float64) {
+	c := orb.Point{(a[0] + b[0]) / 2, (a[1] + b[1]) / 2}
+	return c, Distance(a, b) / 2
+}
+
+// circumcircle returns the circle through the three points. If the points
+// are collinear it returns the circle around the two farthest apart.
+func circumcircle(a, b, c orb.Point) (orb.Point, float64) {
+	bx, by := b[0]-a[0], b[1]-a[1]
+	cx, cy := c[0]-a[0], c[1]-a[1]
+
+	d := 2 * (bx*cy - by*cx)
+	if d == 0 {
+		center, radius := diameterCircle(a, b)
+		if c2, r2 := diameterCircle(a, c); r2 > radius {
+			center, radius = c2, r2
+		}
+		if c3, r3 := diameterCircle(b, c); r3 > radius {
+			center, radius = c3, r3
+		}
+		return center, radius
+	}
+
+	b2 := bx*bx + by*by
+	c2 := cx*cx + cy*cy
+	ux := (cy*b2 - by*c2) / d
+	uy := (bx*c2 - cx*b2) / d
+
+	return orb.Point{a[0] + ux, a[1] + uy}, math.Sqrt(ux*ux + uy*uy)
+}
+
+// caliper is the hull measured relative to one of its edges.
+type caliper struct {
+	origin orb.Point // start of the edge
+	u, v   orb.Point // unit vectors along the edge and into the hull
+
+	height   float64 // distance to the farthest point from the edge
+	min, max float64 // extent of the hull along the edge
+	far      int     // index of the farthest point
+}
+
+// at returns the point at the position in the u, v coordinate system.
+func (c caliper) at(u, v float64) orb.Point {
+	return orb.Point{
+		c.origin[0] + u*c.u[0] + v*c.v[0],
+		c.origin[1] + u*c.u[1] + v*c.v[1],
+	}
+}
+
+// rotatingCalipers calls the function for every edge of the hull.
+// The hull must be counter-clockwise with no collinear points. The extreme
+// points only move forward around the hull as the edges do, so this is linear.
+func rotatingCalipers(hull orb.Ring, f func(c caliper)) {
+	n := len(hull) - 1
+
+	var far, right, left int
+	for i := 0; i < n; i++ {
+		a, b := hull[i], hull[i+1]
+		l := Distance(a, b)
+
+		c := caliper{origin: a}
+		c.u = orb.Point{(b[0] - a[0]) / l, (b[1] - a[1]) / l}
+		c.v = orb.Point{-c.u[1], c.u[0]}
+
+		along := func(k int) float64 {
+			p := hull[k%n]
+			return (p[0]-a[0])*c.u[0] + (p[1]-a[1])*c.u[1]
+		}
+		across := func(k int) float64 {
+			p := hull[k%n]
+			return (p[0]-a[0])*c.v[0] + (p[1]-a[1])*c.v[1]
+		}
+
+		if i == 0 {
+			for k := 1; k < n; k++ {
+				if across(k) > across(far) {
+					far = k
+				}
+				if along(k) > along(right) {
+					right = k
+				}
+				if along(k) < along(left) {
+					left = k
+				}
+			}
+		}
+
+		for across(far+1) > across(far) {
+			far = (far + 1) % n
+		}
+		for along(right+1) > along(right) {
+			right = (right + 1) % n
+		}
+		for along(left+1) < along(left) {
+			left = (left + 1) % n
+		}
+
+		c.height = across(far)
+		c.min, c.max = along(left), along(right)
+		c.far = far % n
+		f(c)
+	}
+}
@@ -0,0 +1,260 @@
+package planar
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestMinimumRotatedRectangle(t *testing.T) {
+	cases := []struct {
+		name   string
+		geom   orb.Geometry
+		result orb.Polygon
+		area   float64
+	}{
+		{
+			name:   "empty",
+			geom:   orb.MultiPoint{},
+			result: nil,
+		},
+		{
+			name:   "single point",
+			geom:   orb.Point{1, 2},
+			result: orb.Polygon{{{1, 2}, {1, 2}}},
+		},
+		{
+			name:   "collinear",
+			geom:   orb.MultiPoint{{1, 1}, {0, 0}, {2, 2}},
+			result: orb.Polygon{{{0, 0}, {2, 2}, {0, 0}}},
+		},
+		{
+			name:   "axis aligned",
+			geom:   orb.MultiPoint{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {1, 1}},
+			result: orb.Polygon{{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}}},
+			area:   8,
+		},
+		{
+			name: "diamond",
+			geom: orb.Polygon{{{0, 2}, {2, 0}, {3, 1}, {1, 3}, {0, 2}}},
+			area: 4,
+		},
+		{
+			name: "triangle",
+			geom: orb.LineString{{0, 0}, {4, 0}, {2, 2}},
+			area: 8,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := MinimumRotatedRectangle(tc.geom)
+			if tc.result != nil && !r.Equal(tc.result) {
+				t.Errorf("incorrect rectangle: %v != %v", r, tc.result)
+			}
+
+			if tc.result == nil && tc.area == 0 && r != nil {
+				t.Errorf("should be nil: %v", r)
+			}
+
+			if tc.area != 0 {
+				if a := Area(r); math.Abs(a-tc.area) > 1e-9 {
+					t.Errorf("incorrect area: %v != %v", a, tc.area)
+				}
+
+				if r[0].Orientation() != orb.CCW {
+					t.Errorf("should be counter-clockwise")
+				}
+			}
+		})
+	}
+}
+
+func TestMinimumRotatedRectangle_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 20; i++ {
+		mp := make(orb.MultiPoint, 0, 100)
+		for j := 0; j < 100; j++ {
+			mp = append(mp, orb.Point{r.Float64() * 10, r.Float64() * 3})
+		}
+
+		rect := MinimumRotatedRectangle(mp)
+		if a := Area(rect); a > Area(mp.Bound())+1e-9 {
+			t.Errorf("should be smaller than the bound: %v", a)
+		}
+
+		for _, p := range mp {
+			if DistanceFrom(rect, p) > 1e-9 && !PolygonContains(rect, p) {
+				t.Fatalf("point not in rectangle: %v", p)
+			}
+		}
+
+		// rotating the points should not change the area
+		rotated := make(orb.MultiPoint, len(mp))
+		s, c := math.Sincos(0.3)
+		for j, p := range mp {
+			rotated[j] = orb.Point{c*p[0] - s*p[1], s*p[0] + c*p[1]}
+		}
+
+		if a, b := Area(rect), Area(MinimumRotatedRectangle(rotated)); math.Abs(a-b) > 1e-9 {
+			t.Errorf("incorrect rotated area: %v != %v", a, b)
+		}
+	}
+}
+
+func TestMinimumDiameter(t *testing.T) {
+	cases := []struct {
+		name  string
+		geom  orb.Geometry
+		width float64
+		line  orb.LineString
+	}{
+		{
+			name:  "empty",
+			geom:  orb.MultiPoint{},
+			width: 0,
+			line:  nil,
+		},
+		{
+			name:  "collinear",
+			geom:  orb.LineString{{0, 0}, {1, 1}, {2, 2}},
+			width: 0,
+			line:  orb.LineString{{0, 0}, {0, 0}},
+		},
+		{
+			name:  "rectangle",
+			geom:  orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{4, 1}},
+			width: 1,
+		},
+		{
+			name:  "diamond",
+			geom:  orb.Polygon{{{0, 2}, {2, 0}, {3, 1}, {1, 3}, {0, 2}}},
+			width: math.Sqrt2,
+		},
+		{
+			name:  "triangle",
+			geom:  orb.Ring{{0, 0}, {4, 0}, {2, 1}, {0, 0}},
+			width: 1,
+			line:  orb.LineString{{2, 1}, {2, 0}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w, line := MinimumDiameter(tc.geom)
+			if math.Abs(w-tc.width) > 1e-9 {
+				t.Errorf("incorrect width: %v != %v", w, tc.width)
+			}
+
+			if tc.line != nil && !line.Equal(tc.line) {
+				t.Errorf("incorrect line: %v != %v", line, tc.line)
+			}
+
+			if tc.line == nil && tc.width == 0 && line != nil {
+				t.Errorf("should be nil: %v", line)
+			}
+
+			if line != nil && math.Abs(Length(line)-w) > 1e-9 {
+				t.Errorf("line length should be the width: %v", Length(line))
+			}
+		})
+	}
+}
+
+func TestMinimumEnclosingCircle(t *testing.T) {
+	cases := []struct {
+		name   string
+		geom   orb.Geometry
+		center orb.Point
+		radius float64
+	}{
+		{
+			name:   "empty",
+			geom:   orb.Collection{},
+			center: orb.Point{},
+			radius: 0,
+		},
+		{
+			name:   "single point",
+			geom:   orb.MultiPoint{{1, 2}, {1, 2}},
+			center: orb.Point{1, 2},
+			radius: 0,
+		},
+		{
+			name:   "two points",
+			geom:   orb.LineString{{0, 0}, {4, 0}},
+			center: orb.Point{2, 0},
+			radius: 2,
+		},
+		{
+			name:   "collinear",
+			geom:   orb.MultiPoint{{0, 0}, {1, 0}, {4, 0}},
+			center: orb.Point{2, 0},
+			radius: 2,
+		},
+		{
+			name:   "square",
+			geom:   orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			center: orb.Point{1, 1},
+			radius: math.Sqrt2,
+		},
+		{
+			name:   "obtuse triangle",
+			geom:   orb.MultiPoint{{0, 0}, {4, 0}, {2, 1}},
+			center: orb.Point{2, 0},
+			radius: 2,
+		},
+		{
+			name:   "circle",
+			geom:   circle(orb.Point{5, 5}, 3, 36),
+			center: orb.Point{5, 5},
+			radius: 3,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, r := MinimumEnclosingCircle(tc.geom)
+			if Distance(c, tc.center) > 1e-9 {
+				t.Errorf("incorrect center: %v != %v", c, tc.center)
+			}
+
+			if math.Abs(r-tc.radius) > 1e-9 {
+				t.Errorf("incorrect radius: %v != %v", r, tc.radius)
+			}
+		})
+	}
+}
+
+func TestMinimumEnclosingCircle_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	for i := 0; i < 20; i++ {
+		mp := make(orb.MultiPoint, 0, 100)
+		for j := 0; j < 100; j++ {
+			mp = append(mp, orb.Point{r.NormFloat64(), r.NormFloat64()})
+		}
+
+		c, radius := MinimumEnclosingCircle(mp)
+
+		// every point is inside and at least two are on the circle
+		on := 0
+		for _, p := range mp {
+			d := Distance(c, p)
+			if d > radius*(1+1e-9) {
+				t.Fatalf("point outside circle: %v", p)
+			}
+
+			if math.Abs(d-radius) < 1e-9 {
+				on++
+			}
+		}
+
+		if on < 2 {
+			t.Errorf("circle should touch at least 2 points: %d", on)
+		}
+	}
+}
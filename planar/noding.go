@@ -0,0 +1,251 @@
+package planar
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/segments"
+)
+
+// segment is a directed edge between two points. The tag is used by the
+// callers to remember where the segment came from, e.g. which operand of
+// an overlay operation.
+type segment struct {
+	a, b orb.Point
+	tag  int
+}
+
+func (s segment) bound() orb.Bound {
+	b := orb.Bound{Min: s.a, Max: s.a}
+	if s.b[0] < b.Min[0] {
+		b.Min[0] = s.b[0]
+	} else {
+		b.Max[0] = s.b[0]
+	}
+
+	if s.b[1] < b.Min[1] {
+		b.Min[1] = s.b[1]
+	} else {
+		b.Max[1] = s.b[1]
+	}
+
+	return b
+}
+
+// nodeSegments splits the segments at every point they intersect
+// any other segment, including segments with the same tag.
+// Intersection points that are within the snap tolerance of each other
+// or of an existing vertex are merged so the split segments share exactly
+// the same vertices. Zero length segments are treated as points, they split
+// the segments they touch and are snapped to the node they are merged with.
+// The result is in input order with every split piece keeping the direction
+// and tag of its parent.
+func nodeSegments(segs []segment) []segment {
+	if len(segs) == 0 {
+		return nil
+	}
+
+	b := segs[0].bound()
+	for _, s := range segs[1:] {
+		b = b.Union(s.bound())
+	}
+
+	snap := newPointSnapper(segments.SnapTolerance(b.Min, b.Max))
+	for _, s := range segs {
+		snap.add(s.a)
+		snap.add(s.b)
+	}
+
+	var nodes []orb.Point
+	seen := make(map[orb.Point]bool)
+	forEachIntersection(segs, func(i, j int, points []orb.Point) {
+		for _, p := range points {
+			p = snap.add(p)
+			if !seen[p] {
+				seen[p] = true
+				nodes = append(nodes, p)
+			}
+		}
+	})
+
+	// split every segment that passes within the tolerance of a node,
+	// not just the ones that created it.
+	splits := make([][]orb.Point, len(segs))
+	bounds := make([]orb.Bound, len(segs))
+	for i, s := range segs {
+		bounds[i] = s.bound().Pad(snap.tolerance)
+	}
+
+	tol2 := snap.tolerance * snap.tolerance
+	forEachContained(bounds, nodes, func(i int, p orb.Point) {
+		s := segs[i]
+		if p == s.a || p == s.b {
+			return
+		}
+
+		if segments.DistanceSquared(s.a, s.b, p) <= tol2 {
+			splits[i] = append(splits[i], p)
+		}
+	})
+
+	result := make([]segment, 0, len(segs))
+	for i, s := range segs {
+		if s.a == s.b {
+			p := snap.add(s.a)
+			result = append(result, segment{a: p, b: p, tag: s.tag})
+			continue
+		}
+
+		points := splits[i]
+		if len(points) == 0 {
+			result = append(result, s)
+			continue
+		}
+
+		dx := s.b[0] - s.a[0]
+		dy := s.b[1] - s.a[1]
+		sort.Slice(points, func(i, j int) bool {
+			ti := (points[i][0]-s.a[0])*dx + (points[i][1]-s.a[1])*dy
+			tj := (points[j][0]-s.a[0])*dx + (points[j][1]-s.a[1])*dy
+			return ti < tj
+		})
+
+		prev := s.a
+		for _, p := range points {
+			if p == prev || p == s.b {
+				continue
+			}
+
+			result = append(result, segment{a: prev, b: p, tag: s.tag})
+			prev = p
+		}
+
+		if prev != s.b {
+			result = append(result, segment{a: prev, b: s.b, tag: s.tag})
+		}
+	}
+
+	return result
+}
+
+// pointSnapper merges points that are within the tolerance of each other.
+// The first point added to a cluster is returned for all the others.
+type pointSnapper struct {
+	tolerance float64
+	cells     map[[2]int64][]orb.Point
+}
+
+func newPointSnapper(tolerance float64) *pointSnapper {
+	return &pointSnapper{
+		tolerance: tolerance,
+		cells:     make(map[[2]int64][]orb.Point),
+	}
+}
+
+func (ps *pointSnapper) cell(p orb.Point) [2]int64 {
+	size := 2 * ps.tolerance
+	return [2]int64{
+		int64(math.Floor(p[0] / size)),
+		int64(math.Floor(p[1] / size)),
+	}
+}
+
+// add returns the existing point within the tolerance or
+// adds the point if there is none.
+func (ps *pointSnapper) add(p orb.Point) orb.Point {
+	c := ps.cell(p)
+	tol2 := ps.tolerance * ps.tolerance
+	for x := c[0] - 1; x <= c[0]+1; x++ {
+		for y := c[1] - 1; y <= c[1]+1; y++ {
+			for _, q := range ps.cells[[2]int64{x, y}] {
+				if DistanceSquared(p, q) <= tol2 {
+					return q
+				}
+			}
+		}
+	}
+
+	ps.cells[c] = append(ps.cells[c], p)
+	return p
+}
+
+// forEachIntersection calls the function for every pair of segments
+// that intersect. It uses a sweep along the x axis so only segments
+// with overlapping x ranges are compared.
+func forEachIntersection(segs []segment, f func(i, j int, points []orb.Point)) {
+	forEachIntersectionUntil(segs, func(i, j int, points []orb.Point) bool {
+		f(i, j, points)
+		return true
+	})
+}
+
+// forEachIntersectionUntil is the same as forEachIntersection but
+// stops early if the function returns false.
+func forEachIntersectionUntil(segs []segment, f func(i, j int, points []orb.Point) bool) {
+	type item struct {
+		index int
+		bound orb.Bound
+	}
+
+	items := make([]item, 0, len(segs))
+	for i, s := range segs {
+		items = append(items, item{index: i, bound: s.bound()})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].bound.Min[0] < items[j].bound.Min[0]
+	})
+
+	for i, it := range items {
+		tol := segments.SnapTolerance(it.bound.Min, it.bound.Max)
+		for _, next := range items[i+1:] {
+			if next.bound.Min[0] > it.bound.Max[0]+tol {
+				break
+			}
+
+			if next.bound.Min[1] > it.bound.Max[1]+tol ||
+				next.bound.Max[1] < it.bound.Min[1]-tol {
+				continue
+			}
+
+			s1, s2 := segs[it.index], segs[next.index]
+			points := segments.Intersection(s1.a, s1.b, s2.a, s2.b)
+			if len(points) == 0 {
+				continue
+			}
+
+			if !f(it.index, next.index, points) {
+				return
+			}
+		}
+	}
+}
+
+// forEachContained calls the function for every point contained by
+// each of the bounds. The points are sorted along the x axis so only
+// the points in the x range of a bound are checked.
+func forEachContained(bounds []orb.Bound, points []orb.Point, f func(i int, p orb.Point)) {
+	sorted := append([]orb.Point(nil), points...)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i][0] < sorted[j][0]
+	})
+
+	for i, b := range bounds {
+		k := sort.Search(len(sorted), func(k int) bool {
+			return sorted[k][0] >= b.Min[0]
+		})
+
+		for _, p := range sorted[k:] {
+			if p[0] > b.Max[0] {
+				break
+			}
+
+			if p[1] < b.Min[1] || p[1] > b.Max[1] {
+				continue
+			}
+
+			f(i, p)
+		}
+	}
+}
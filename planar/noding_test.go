@@ -0,0 +1,111 @@
+package planar
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/segments"
+)
+
+func TestForEachContained(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	var bounds []orb.Bound
+	for i := 0; i < 100; i++ {
+		a := orb.Point{r.Float64() * 100, r.Float64() * 100}
+		b := orb.Point{r.Float64() * 100, r.Float64() * 100}
+		if i%2 == 0 {
+			b = orb.Point{a[0] + r.Float64()*5, a[1] + r.Float64()*5}
+		}
+		bounds = append(bounds, orb.Bound{Min: a, Max: a}.Extend(b))
+	}
+
+	var points []orb.Point
+	for i := 0; i < 300; i++ {
+		points = append(points, orb.Point{r.Float64() * 100, r.Float64() * 100})
+	}
+
+	// points on the edges of the bounds
+	points = append(points, bounds[0].Min, bounds[1].Max, bounds[2].LeftTop())
+
+	type pair struct {
+		i int
+		p orb.Point
+	}
+	expected := make(map[pair]bool)
+	for i, b := range bounds {
+		for _, p := range points {
+			if b.Contains(p) {
+				expected[pair{i, p}] = true
+			}
+		}
+	}
+
+	found := make(map[pair]bool)
+	forEachContained(bounds, points, func(i int, p orb.Point) {
+		if found[pair{i, p}] {
+			t.Errorf("point found twice: %v %v", i, p)
+		}
+		found[pair{i, p}] = true
+	})
+
+	if len(found) != len(expected) {
+		t.Errorf("incorrect number of points: %v != %v", len(found), len(expected))
+	}
+
+	for p := range expected {
+		if !found[p] {
+			t.Errorf("point not found: %v", p)
+		}
+	}
+}
+
+func TestForEachIntersection(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+
+	var segs []segment
+	for i := 0; i < 300; i++ {
+		a := orb.Point{r.Float64() * 100, r.Float64() * 100}
+		if i%10 == 0 {
+			// some long segments that overlap many others
+			segs = append(segs, segment{a: a, b: orb.Point{r.Float64() * 100, r.Float64() * 100}})
+			continue
+		}
+
+		b := orb.Point{a[0] + r.Float64()*5, a[1] + r.Float64()*5}
+		segs = append(segs, segment{a: a, b: b})
+	}
+
+	type pair struct{ i, j int }
+	expected := make(map[pair]bool)
+	for i := range segs {
+		for j := i + 1; j < len(segs); j++ {
+			if len(segments.Intersection(segs[i].a, segs[i].b, segs[j].a, segs[j].b)) > 0 {
+				expected[pair{i, j}] = true
+			}
+		}
+	}
+
+	found := make(map[pair]bool)
+	forEachIntersection(segs, func(i, j int, points []orb.Point) {
+		if j < i {
+			i, j = j, i
+		}
+
+		if found[pair{i, j}] {
+			t.Errorf("pair found twice: %v %v", i, j)
+		}
+		found[pair{i, j}] = true
+	})
+
+	if len(found) != len(expected) {
+		t.Errorf("incorrect number of pairs: %v != %v", len(found), len(expected))
+	}
+
+	for p := range expected {
+		if !found[p] {
+			t.Errorf("pair not found: %v", p)
+		}
+	}
+}
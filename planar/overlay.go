@@ -0,0 +1,503 @@
+package planar
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/segments"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// overlayOp decides if a point is in the result given if it is
+// within the first and second operand.
+type overlayOp func(a, b bool) bool
+
+var (
+	unionOp         overlayOp = func(a, b bool) bool { return a || b }
+	intersectionOp  overlayOp = func(a, b bool) bool { return a && b }
+	differenceOp    overlayOp = func(a, b bool) bool { return a && !b }
+	symDifferenceOp overlayOp = func(a, b bool) bool { return a != b }
+)
+
+// Union returns the area covered by either of the geometries.
+// Only the 2d parts, i.e. rings, polygons, multi-polygons and bounds,
+// of the geometries are considered. Overlapping polygons within the same
+// geometry are also dissolved so Union(g, nil) can be used to merge
+// the polygons of a single geometry. The result will have outer rings in
+// counter-clockwise order and holes in clockwise order.
+func Union(a, b orb.Geometry) orb.MultiPolygon {
+	return overlay(a, b, unionOp)
+}
+
+// Intersection returns the area covered by both of the geometries.
+// Only the 2d parts of the geometries are considered. Polygons that
+// only touch along an edge or at a point do not create any area.
+func Intersection(a, b orb.Geometry) orb.MultiPolygon {
+	return overlay(a, b, intersectionOp)
+}
+
+// Difference returns the area of the first geometry that is not covered
+// by the second. Only the 2d parts of the geometries are considered.
+func Difference(a, b orb.Geometry) orb.MultiPolygon {
+	return overlay(a, b, differenceOp)
+}
+
+// SymDifference returns the area covered by exactly one of the geometries.
+// Only the 2d parts of the geometries are considered.
+func SymDifference(a, b orb.Geometry) orb.MultiPolygon {
+	return overlay(a, b, symDifferenceOp)
+}
+
+// overlay computes the boolean operation using a noded arrangement of the
+// edges of both geometries. Every unique edge is labeled with the winding
+// number of each operand on either side. Edges that separate the inside of
+// the result from the outside are then linked into rings.
+func overlay(a, b orb.Geometry, op overlayOp) orb.MultiPolygon {
+	var segs []segment
+	segs = appendAreaSegments(segs, a, 0)
+	segs = appendAreaSegments(segs, b, 1)
+
+	return overlaySegments(segs, op)
+}
+
+// overlaySegments computes the boolean operation on directed segments
+// tagged with their operand. Inside each operand the winding number,
+// the edges going counter-clockwise minus clockwise around a point, is positive.
+func overlaySegments(segs []segment, op overlayOp) orb.MultiPolygon {
+	if len(segs) == 0 {
+		return nil
+	}
+
+	segs = nodeSegments(segs)
+	g := newOverlayGraph(segs)
+
+	var result []segment
+	for _, e := range g.edges {
+		left, right := g.windings(e)
+		inLeft := op(left[0] > 0, left[1] > 0)
+		inRight := op(right[0] > 0, right[1] > 0)
+
+		if inLeft == inRight {
+			continue
+		}
+
+		if inLeft {
+			result = append(result, segment{a: e.a, b: e.b})
+		} else {
+			result = append(result, segment{a: e.b, b: e.a})
+		}
+	}
+
+	return buildMultiPolygon(linkRings(result))
+}
+
+// appendAreaSegments adds the edges of the 2d parts of the geometry
+// to the set. Outer rings are made counter-clockwise and holes clockwise
+// so the winding number is positive inside the geometry.
+func appendAreaSegments(segs []segment, g orb.Geometry, tag int) []segment {
+	if g == nil {
+		return segs
+	}
+
+	switch g := g.(type) {
+	case orb.Point, orb.MultiPoint, orb.LineString, orb.MultiLineString:
+		return segs
+	case orb.Ring:
+		return appendRingSegments(segs, g, orb.CCW, tag)
+	case orb.Polygon:
+		return appendPolygonSegments(segs, g, tag)
+	case orb.MultiPolygon:
+		for _, p := range g {
+			segs = appendPolygonSegments(segs, p, tag)
+		}
+		return segs
+	case orb.Collection:
+		for _, c := range g {
+			segs = appendAreaSegments(segs, c, tag)
+		}
+		return segs
+	case orb.Bound:
+		if g.IsEmpty() {
+			return segs
+		}
+		return appendRingSegments(segs, g.ToRing(), orb.CCW, tag)
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return appendAreaSegments(segs, zm.ToXY(g), tag)
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+func appendPolygonSegments(segs []segment, p orb.Polygon, tag int) []segment {
+	for i, r := range p {
+		if i == 0 {
+			segs = appendRingSegments(segs, r, orb.CCW, tag)
+		} else {
+			segs = appendRingSegments(segs, r, orb.CW, tag)
+		}
+	}
+
+	return segs
+}
+
+// appendRingSegments adds the edges of the ring in the given orientation.
+// Rings that are not closed are implicitly closed.
+func appendRingSegments(segs []segment, r orb.Ring, o orb.Orientation, tag int) []segment {
+	if len(r) < 3 {
+		return segs
+	}
+
+	ro := r.Orientation()
+	if ro == 0 {
+		return segs
+	}
+
+	reverse := ro != o
+	for i := range r {
+		p1 := r[i]
+		p2 := r[(i+1)%len(r)]
+		if p1 == p2 {
+			continue
+		}
+
+		if reverse {
+			p1, p2 = p2, p1
+		}
+		segs = append(segs, segment{a: p1, b: p2, tag: tag})
+	}
+
+	return segs
+}
+
+// overlayEdge is a unique undirected edge in the noded arrangement.
+// The counts are the number of times each operand has the edge
+// in the a->b direction minus the times in the b->a direction.
+type overlayEdge struct {
+	a, b   orb.Point
+	counts [2]int
+}
+
+type overlayGraph struct {
+	edges []*overlayEdge
+	keys  map[[2]orb.Point]*overlayEdge
+
+	// segments of each operand, indexed by strips to speed up
+	// the ray casting.
+	segs    [2][]segment
+	xStrips [2]*stripIndex
+	yStrips [2]*stripIndex
+}
+
+func newOverlayGraph(segs []segment) *overlayGraph {
+	g := &overlayGraph{
+		keys: make(map[[2]orb.Point]*overlayEdge, len(segs)),
+	}
+
+	for _, s := range segs {
+		g.segs[s.tag] = append(g.segs[s.tag], s)
+
+		key, dir := edgeKey(s.a, s.b)
+		e := g.keys[key]
+		if e == nil {
+			e = &overlayEdge{a: key[0], b: key[1]}
+			g.keys[key] = e
+			g.edges = append(g.edges, e)
+		}
+		e.counts[s.tag] += dir
+	}
+
+	for i := range g.segs {
+		g.xStrips[i] = newStripIndex(g.segs[i], 1)
+		g.yStrips[i] = newStripIndex(g.segs[i], 0)
+	}
+
+	return g
+}
+
+// edgeKey returns the endpoints sorted so the same key is used
+// for both directions. The direction is 1 if the points were in order.
+func edgeKey(a, b orb.Point) ([2]orb.Point, int) {
+	if a[0] < b[0] || (a[0] == b[0] && a[1] < b[1]) {
+		return [2]orb.Point{a, b}, 1
+	}
+
+	return [2]orb.Point{b, a}, -1
+}
+
+// windings returns the winding number of each operand on the left
+// and right side of the edge, in the a->b direction.
+func (g *overlayGraph) windings(e *overlayEdge) (left, right [2]int) {
+	m := orb.Point{(e.a[0] + e.b[0]) / 2, (e.a[1] + e.b[1]) / 2}
+	dx := e.b[0] - e.a[0]
+	dy := e.b[1] - e.a[1]
+
+	key := [2]orb.Point{e.a, e.b}
+	for i := range g.segs {
+		if math.Abs(dy) >= math.Abs(dx) {
+			// the ray goes in the +x direction, to the right of an upward edge.
+			w := g.windingX(i, key, m)
+			if dy > 0 {
+				right[i], left[i] = w, w+e.counts[i]
+			} else {
+				left[i], right[i] = w, w-e.counts[i]
+			}
+		} else {
+			// the ray goes in the +y direction, to the left of an edge heading +x.
+			w := g.windingY(i, key, m)
+			if dx > 0 {
+				left[i], right[i] = w, w-e.counts[i]
+			} else {
+				right[i], left[i] = w, w+e.counts[i]
+			}
+		}
+	}
+
+	return left, right
+}
+
+// windingX computes the winding number of the operand around the point
+// using a ray in the +x direction. Segments matching the key are ignored.
+func (g *overlayGraph) windingX(tag int, exclude [2]orb.Point, m orb.Point) int {
+	w := 0
+	for _, i := range g.xStrips[tag].query(m[1]) {
+		s := g.segs[tag][i]
+		if (s.a[1] > m[1]) == (s.b[1] > m[1]) {
+			continue
+		}
+
+		if key, _ := edgeKey(s.a, s.b); key == exclude {
+			continue
+		}
+
+		x := s.a[0] + (m[1]-s.a[1])*(s.b[0]-s.a[0])/(s.b[1]-s.a[1])
+		if x <= m[0] {
+			continue
+		}
+
+		if s.b[1] > s.a[1] {
+			w++
+		} else {
+			w--
+		}
+	}
+
+	return w
+}
+
+// windingY computes the winding number of the operand around the point
+// using a ray in the +y direction. Segments matching the key are ignored.
+func (g *overlayGraph) windingY(tag int, exclude [2]orb.Point, m orb.Point) int {
+	w := 0
+	for _, i := range g.yStrips[tag].query(m[0]) {
+		s := g.segs[tag][i]
+		if (s.a[0] > m[0]) == (s.b[0] > m[0]) {
+			continue
+		}
+
+		if key, _ := edgeKey(s.a, s.b); key == exclude {
+			continue
+		}
+
+		y := s.a[1] + (m[0]-s.a[0])*(s.b[1]-s.a[1])/(s.b[0]-s.a[0])
+		if y <= m[1] {
+			continue
+		}
+
+		if s.b[0] < s.a[0] {
+			w++
+		} else {
+			w--
+		}
+	}
+
+	return w
+}
+
+// stripIndex buckets segments into strips along one axis so only
+// the segments that span a coordinate need to be considered.
+type stripIndex struct {
+	min, size float64
+	strips    [][]int
+}
+
+func newStripIndex(segs []segment, axis int) *stripIndex {
+	if len(segs) == 0 {
+		return &stripIndex{}
+	}
+
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, s := range segs {
+		min = math.Min(min, math.Min(s.a[axis], s.b[axis]))
+		max = math.Max(max, math.Max(s.a[axis], s.b[axis]))
+	}
+
+	// more strips means less segments to check for each query but more
+	// memory for segments that span many strips.
+	n := len(segs)/4 + 1
+	if n > 4096 {
+		n = 4096
+	}
+	si := &stripIndex{
+		min:    min,
+		size:   (max - min) / float64(n),
+		strips: make([][]int, n),
+	}
+
+	for i, s := range segs {
+		lo := si.strip(math.Min(s.a[axis], s.b[axis]))
+		hi := si.strip(math.Max(s.a[axis], s.b[axis]))
+		for j := lo; j <= hi; j++ {
+			si.strips[j] = append(si.strips[j], i)
+		}
+	}
+
+	return si
+}
+
+func (si *stripIndex) strip(v float64) int {
+	if si.size == 0 {
+		return 0
+	}
+
+	i := int((v - si.min) / si.size)
+	if i < 0 {
+		return 0
+	}
+
+	if i >= len(si.strips) {
+		return len(si.strips) - 1
+	}
+
+	return i
+}
+
+func (si *stripIndex) query(v float64) []int {
+	if len(si.strips) == 0 {
+		return nil
+	}
+
+	return si.strips[si.strip(v)]
+}
+
+// linkRings joins directed edges into closed rings. The edges must form
+// a set of closed loops, ie. every point has the same number of edges
+// coming in as going out. When there is a choice the leftmost turn is taken
+// so the loops are as small as possible and only touch at points.
+func linkRings(segs []segment) []orb.Ring {
+	outgoing := make(map[orb.Point][]int, len(segs))
+	for i, s := range segs {
+		outgoing[s.a] = append(outgoing[s.a], i)
+	}
+
+	used := make([]bool, len(segs))
+	var rings []orb.Ring
+	for i := range segs {
+		if used[i] {
+			continue
+		}
+
+		used[i] = true
+		start := segs[i].a
+		ring := orb.Ring{start}
+
+		current := i
+		for {
+			s := segs[current]
+			ring = append(ring, s.b)
+			if s.b == start {
+				break
+			}
+
+			next := -1
+			best := math.Inf(-1)
+			for _, j := range outgoing[s.b] {
+				if used[j] {
+					continue
+				}
+
+				n := segs[j]
+				turn := math.Atan2(
+					segments.Orient(s.a, s.b, n.b),
+					(s.b[0]-s.a[0])*(n.b[0]-n.a[0])+(s.b[1]-s.a[1])*(n.b[1]-n.a[1]),
+				)
+				if turn > best {
+					best = turn
+					next = j
+				}
+			}
+
+			if next == -1 {
+				// dangling edges, should not happen with a valid arrangement.
+				ring = nil
+				break
+			}
+
+			used[next] = true
+			current = next
+		}
+
+		if len(ring) >= 4 {
+			rings = append(rings, ring)
+		}
+	}
+
+	return rings
+}
+
+// buildMultiPolygon groups the rings into polygons. Counter-clockwise
+// rings are outer rings and clockwise rings are holes. Each hole is
+// assigned to the smallest outer ring that contains it.
+func buildMultiPolygon(rings []orb.Ring) orb.MultiPolygon {
+	type shell struct {
+		area  float64
+		bound orb.Bound
+		index int
+	}
+
+	var (
+		result orb.MultiPolygon
+		shells []shell
+		holes  []orb.Ring
+	)
+
+	for _, r := range rings {
+		_, area := ringCentroidArea(r)
+		if area > 0 {
+			shells = append(shells, shell{area: area, bound: r.Bound(), index: len(result)})
+			result = append(result, orb.Polygon{r})
+		} else if area < 0 {
+			holes = append(holes, r)
+		}
+	}
+
+	for _, h := range holes {
+		// the midpoint of an edge can not be on another ring since
+		// the rings only touch at points.
+		p := orb.Point{(h[0][0] + h[1][0]) / 2, (h[0][1] + h[1][1]) / 2}
+
+		best := -1
+		for i, s := range shells {
+			if !s.bound.Contains(p) {
+				continue
+			}
+
+			if best != -1 && shells[best].area <= s.area {
+				continue
+			}
+
+			if RingContains(result[s.index][0], p) {
+				best = i
+			}
+		}
+
+		if best != -1 {
+			idx := shells[best].index
+			result[idx] = append(result[idx], h)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,347 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestUnion(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     orb.Geometry
+		area     float64
+		polygons int
+		holes    int
+	}{
+		{
+			name:     "overlapping squares",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			b:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}},
+			area:     7,
+			polygons: 1,
+		},
+		{
+			name:     "shared edge",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			b:        orb.Bound{Min: orb.Point{1, 0}, Max: orb.Point{2, 1}},
+			area:     2,
+			polygons: 1,
+		},
+		{
+			name:     "partially shared edge",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 2}},
+			b:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{2, 3}},
+			area:     4,
+			polygons: 1,
+		},
+		{
+			name:     "touching at a point",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			b:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{2, 2}},
+			area:     2,
+			polygons: 2,
+		},
+		{
+			name:     "disjoint",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			b:        orb.Bound{Min: orb.Point{5, 5}, Max: orb.Point{6, 6}},
+			area:     2,
+			polygons: 2,
+		},
+		{
+			name: "hole filled",
+			a: orb.Polygon{
+				{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+			},
+			b:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}},
+			area:     16,
+			polygons: 1,
+		},
+		{
+			name: "hole partially filled",
+			a: orb.Polygon{
+				{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+			},
+			b:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{2, 3}},
+			area:     14,
+			polygons: 1,
+			holes:    1,
+		},
+		{
+			name: "island in hole",
+			a: orb.Polygon{
+				{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+			},
+			b:        orb.Bound{Min: orb.Point{1.5, 1.5}, Max: orb.Point{2.5, 2.5}},
+			area:     13,
+			polygons: 2,
+			holes:    1,
+		},
+		{
+			name:     "clockwise input",
+			a:        orb.Ring{{0, 0}, {0, 2}, {2, 2}, {2, 0}, {0, 0}},
+			b:        orb.Ring{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+			area:     7,
+			polygons: 1,
+		},
+		{
+			name: "dissolve single multipolygon",
+			a: orb.MultiPolygon{
+				orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}}.ToPolygon(),
+				orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}}.ToPolygon(),
+				orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}}.ToPolygon(),
+			},
+			area:     7,
+			polygons: 1,
+		},
+		{
+			name:     "lines are ignored",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			b:        orb.LineString{{0, 0}, {5, 5}},
+			area:     1,
+			polygons: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := Union(tc.a, tc.b)
+			checkOverlayResult(t, mp, tc.area, tc.polygons, tc.holes)
+		})
+	}
+}
+
+func TestIntersection(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     orb.Geometry
+		area     float64
+		polygons int
+		holes    int
+	}{
+		{
+			name:     "overlapping squares",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			b:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}},
+			area:     1,
+			polygons: 1,
+		},
+		{
+			name:     "shared edge",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			b:        orb.Bound{Min: orb.Point{1, 0}, Max: orb.Point{2, 1}},
+			area:     0,
+			polygons: 0,
+		},
+		{
+			name:     "same polygon",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			b:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}},
+			area:     1,
+			polygons: 1,
+		},
+		{
+			name:     "cross",
+			a:        orb.Bound{Min: orb.Point{0, 1}, Max: orb.Point{3, 2}},
+			b:        orb.Bound{Min: orb.Point{1, 0}, Max: orb.Point{2, 3}},
+			area:     1,
+			polygons: 1,
+		},
+		{
+			name: "with hole",
+			a: orb.Polygon{
+				{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+			},
+			b:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{4, 2}},
+			area:     6,
+			polygons: 1,
+		},
+		{
+			name: "keeps hole",
+			a: orb.Polygon{
+				{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+			},
+			b:        orb.Bound{Min: orb.Point{-1, -1}, Max: orb.Point{5, 5}},
+			area:     12,
+			polygons: 1,
+			holes:    1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := Intersection(tc.a, tc.b)
+			checkOverlayResult(t, mp, tc.area, tc.polygons, tc.holes)
+		})
+	}
+}
+
+func TestDifference(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     orb.Geometry
+		area     float64
+		polygons int
+		holes    int
+	}{
+		{
+			name:     "overlapping squares",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+			b:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}},
+			area:     3,
+			polygons: 1,
+		},
+		{
+			name:     "create hole",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{4, 4}},
+			b:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}},
+			area:     12,
+			polygons: 1,
+			holes:    1,
+		},
+		{
+			name:     "split in two",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{3, 1}},
+			b:        orb.Bound{Min: orb.Point{1, -1}, Max: orb.Point{2, 2}},
+			area:     2,
+			polygons: 2,
+		},
+		{
+			name:     "shared edge",
+			a:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 1}},
+			b:        orb.Bound{Min: orb.Point{1, 0}, Max: orb.Point{2, 1}},
+			area:     1,
+			polygons: 1,
+		},
+		{
+			name:     "everything",
+			a:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{2, 2}},
+			b:        orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{3, 3}},
+			area:     0,
+			polygons: 0,
+		},
+		{
+			name:     "nothing",
+			a:        orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{2, 2}},
+			b:        nil,
+			area:     1,
+			polygons: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := Difference(tc.a, tc.b)
+			checkOverlayResult(t, mp, tc.area, tc.polygons, tc.holes)
+		})
+	}
+}
+
+func TestSymDifference(t *testing.T) {
+	a := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}}
+	b := orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}}
+
+	mp := SymDifference(a, b)
+	checkOverlayResult(t, mp, 6, 2, 0)
+
+	mp = SymDifference(a, a)
+	checkOverlayResult(t, mp, 0, 0, 0)
+}
+
+func TestOverlay_orientation(t *testing.T) {
+	a := orb.Polygon{
+		{{0, 0}, {0, 4}, {4, 4}, {4, 0}, {0, 0}},
+		{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}},
+	}
+
+	mp := Union(a, nil)
+	if len(mp) != 1 || len(mp[0]) != 2 {
+		t.Fatalf("incorrect result: %v", mp)
+	}
+
+	if o := mp[0][0].Orientation(); o != orb.CCW {
+		t.Errorf("outer ring should be ccw: %v", o)
+	}
+
+	if o := mp[0][1].Orientation(); o != orb.CW {
+		t.Errorf("hole should be cw: %v", o)
+	}
+
+	for _, r := range mp[0] {
+		if !r.Closed() {
+			t.Errorf("ring should be closed: %v", r)
+		}
+	}
+}
+
+func TestOverlay_circles(t *testing.T) {
+	c1 := circle(orb.Point{0, 0}, 1, 64)
+	c2 := circle(orb.Point{1, 0}, 1, 64)
+
+	a1 := Area(c1)
+	a2 := Area(c2)
+
+	union := Area(Union(c1, c2))
+	inter := Area(Intersection(c1, c2))
+	diff := Area(Difference(c1, c2))
+	sym := Area(SymDifference(c1, c2))
+
+	if v := union + inter; math.Abs(v-a1-a2) > epsilon {
+		t.Errorf("union + intersection should equal sum: %v != %v", v, a1+a2)
+	}
+
+	if v := diff + inter; math.Abs(v-a1) > epsilon {
+		t.Errorf("difference + intersection should equal area: %v != %v", v, a1)
+	}
+
+	if v := union - inter; math.Abs(v-sym) > epsilon {
+		t.Errorf("union - intersection should equal sym difference: %v != %v", v, sym)
+	}
+}
+
+func TestOverlay_allGeometries(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		Union(g, orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}})
+		Intersection(orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}}, g)
+	}
+}
+
+func checkOverlayResult(t testing.TB, mp orb.MultiPolygon, area float64, polygons, holes int) {
+	t.Helper()
+
+	if a := Area(mp); math.Abs(a-area) > epsilon {
+		t.Errorf("incorrect area: %v != %v", a, area)
+	}
+
+	if len(mp) != polygons {
+		t.Errorf("incorrect number of polygons: %v != %v", len(mp), polygons)
+		t.Logf("%v", mp)
+	}
+
+	h := 0
+	for _, p := range mp {
+		h += len(p) - 1
+	}
+
+	if h != holes {
+		t.Errorf("incorrect number of holes: %v != %v", h, holes)
+		t.Logf("%v", mp)
+	}
+}
+
+func circle(c orb.Point, r float64, n int) orb.Ring {
+	ring := make(orb.Ring, 0, n+1)
+	for i := 0; i < n; i++ {
+		a := 2 * math.Pi * float64(i) / float64(n)
+		ring = append(ring, orb.Point{c[0] + r*math.Cos(a), c[1] + r*math.Sin(a)})
+	}
+
+	return append(ring, ring[0])
+}
@@ -0,0 +1,197 @@
+package planar
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// PointOnSurface returns a point guaranteed to be on the geometry.
+// For areal geometries the point is inside the area, not on the boundary.
+// It is the midpoint of the widest interior section of a horizontal line
+// through the middle of the polygons. For lines it is the vertex closest to
+// the centroid, preferring non-end vertices, and for points the point closest
+// to the centroid. Collections use their highest dimension parts.
+// This is much faster than Polylabel but not as good for labels.
+func PointOnSurface(g orb.Geometry) orb.Point {
+	if g == nil {
+		return orb.Point{}
+	}
+
+	if p, ok := areaInteriorPoint(g); ok {
+		return p
+	}
+
+	// areas without width fall through to their rings
+	if p, ok := lineInteriorPoint(g); ok {
+		return p
+	}
+
+	points := appendPoints(nil, g)
+	return closestTo(multiPointCentroid(points), points)
+}
+
+// areaInteriorPoint returns the midpoint of the widest section of a
+// horizontal line inside any of the polygons.
+func areaInteriorPoint(g orb.Geometry) (orb.Point, bool) {
+	var result orb.Point
+	width := 0.0
+
+	var walk func(g orb.Geometry)
+	walk = func(g orb.Geometry) {
+		switch g := g.(type) {
+		case orb.Ring:
+			walk(orb.Polygon{g})
+		case orb.Polygon:
+			if p, w := polygonInteriorPoint(g); w > width {
+				result, width = p, w
+			}
+		case orb.MultiPolygon:
+			for _, p := range g {
+				walk(p)
+			}
+		case orb.Collection:
+			for _, c := range g {
+				walk(c)
+			}
+		case orb.Bound:
+			walk(g.ToPolygon())
+		default:
+			if zm.LayoutOf(g) != zm.XY {
+				walk(zm.ToXY(g))
+			}
+		}
+	}
+	walk(g)
+
+	return result, width > 0
+}
+
+// polygonInteriorPoint returns the midpoint of the widest section of the
+// scan line inside the polygon and the width of that section.
+func polygonInteriorPoint(p orb.Polygon) (orb.Point, float64) {
+	if len(p) == 0 || len(p[0]) == 0 {
+		return orb.Point{}, 0
+	}
+
+	// Use a y between the two vertex y values closest to the middle
+	// so the scan line does not go through any vertex.
+	b := p[0].Bound()
+	mid := (b.Min[1] + b.Max[1]) / 2
+	lo, hi := b.Min[1], b.Max[1]
+	for _, r := range p {
+		for _, point := range r {
+			y := point[1]
+			if y <= mid && y > lo {
+				lo = y
+			} else if y > mid && y < hi {
+				hi = y
+			}
+		}
+	}
+	y := (lo + hi) / 2
+
+	var xs []float64
+	for _, r := range p {
+		for i := 1; i < len(r); i++ {
+			a, b := r[i-1], r[i]
+			if (a[1] > y) == (b[1] > y) {
+				continue
+			}
+
+			xs = append(xs, a[0]+(y-a[1])*(b[0]-a[0])/(b[1]-a[1]))
+		}
+	}
+	sort.Float64s(xs)
+
+	var result orb.Point
+	width := 0.0
+	for i := 1; i < len(xs); i += 2 {
+		if w := xs[i] - xs[i-1]; w > width {
+			result = orb.Point{(xs[i] + xs[i-1]) / 2, y}
+			width = w
+		}
+	}
+
+	return result, width
+}
+
+// lineInteriorPoint returns the non-end vertex of the lines closest to
+// their centroid or the closest end vertex if there are none.
+func lineInteriorPoint(g orb.Geometry) (orb.Point, bool) {
+	var mls orb.MultiLineString
+
+	var walk func(g orb.Geometry)
+	walk = func(g orb.Geometry) {
+		switch g := g.(type) {
+		case orb.Point, orb.MultiPoint:
+		case orb.LineString:
+			mls = append(mls, g)
+		case orb.MultiLineString:
+			mls = append(mls, g...)
+		case orb.Ring:
+			mls = append(mls, orb.LineString(g))
+		case orb.Polygon:
+			for _, r := range g {
+				walk(r)
+			}
+		case orb.MultiPolygon:
+			for _, p := range g {
+				walk(p)
+			}
+		case orb.Collection:
+			for _, c := range g {
+				walk(c)
+			}
+		case orb.Bound:
+			walk(g.ToRing())
+		default:
+			if zm.LayoutOf(g) != zm.XY {
+				walk(zm.ToXY(g))
+				return
+			}
+
+			panic(fmt.Sprintf("geometry type not supported: %T", g))
+		}
+	}
+	walk(g)
+
+	var inner, ends []orb.Point
+	for _, ls := range mls {
+		if len(ls) == 0 {
+			continue
+		}
+
+		if len(ls) > 2 {
+			inner = append(inner, ls[1:len(ls)-1]...)
+		}
+		ends = append(ends, ls[0], ls[len(ls)-1])
+	}
+
+	if len(ends) == 0 {
+		return orb.Point{}, false
+	}
+
+	c := multiLineStringCentroid(mls)
+	if len(inner) > 0 {
+		return closestTo(c, inner), true
+	}
+
+	return closestTo(c, ends), true
+}
+
+// closestTo returns the point closest to p.
+func closestTo(p orb.Point, points []orb.Point) orb.Point {
+	var result orb.Point
+	min := math.Inf(1)
+	for _, point := range points {
+		if d := DistanceSquared(p, point); d < min {
+			result, min = point, d
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,108 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestPointOnSurface(t *testing.T) {
+	u := orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {7, 10}, {7, 3}, {3, 3}, {3, 10}, {0, 10}, {0, 0}}}
+
+	cases := []struct {
+		name   string
+		geom   orb.Geometry
+		result orb.Point
+	}{
+		{
+			name:   "nil",
+			geom:   nil,
+			result: orb.Point{},
+		},
+		{
+			name:   "point",
+			geom:   orb.Point{1, 2},
+			result: orb.Point{1, 2},
+		},
+		{
+			name:   "multi point",
+			geom:   orb.MultiPoint{{0, 0}, {1, 1}, {3, 3}},
+			result: orb.Point{1, 1},
+		},
+		{
+			name:   "line string",
+			geom:   orb.LineString{{0, 0}, {1, 0}, {5, 0}, {10, 0}},
+			result: orb.Point{5, 0},
+		},
+		{
+			name:   "two point line string",
+			geom:   orb.LineString{{0, 0}, {10, 0}},
+			result: orb.Point{0, 0},
+		},
+		{
+			name:   "u shape",
+			geom:   u,
+			result: orb.Point{1.5, 6.5},
+		},
+		{
+			name: "polygon with hole",
+			geom: orb.Polygon{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{1, 2}, {1, 8}, {8, 8}, {8, 2}, {1, 2}},
+			},
+			result: orb.Point{9, 5},
+		},
+		{
+			name: "multi polygon uses widest",
+			geom: orb.MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+				{{{5, 0}, {9, 0}, {9, 1}, {5, 1}, {5, 0}}},
+			},
+			result: orb.Point{7, 0.5},
+		},
+		{
+			name:   "collection prefers area",
+			geom:   orb.Collection{orb.Point{100, 100}, orb.LineString{{0, 0}, {1, 1}, {2, 2}}, orb.Bound{Max: orb.Point{2, 2}}},
+			result: orb.Point{1, 1},
+		},
+		{
+			name:   "zero area polygon",
+			geom:   orb.Polygon{{{0, 0}, {1, 0}, {2, 0}, {0, 0}}},
+			result: orb.Point{1, 0},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := PointOnSurface(tc.geom)
+			if !p.Equal(tc.result) {
+				t.Errorf("incorrect point: %v != %v", p, tc.result)
+			}
+		})
+	}
+}
+
+func TestPointOnSurface_inside(t *testing.T) {
+	for _, g := range []orb.Geometry{
+		orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {7, 10}, {7, 3}, {3, 3}, {3, 10}, {0, 10}, {0, 0}}},
+		orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {2, 1}, {0, 4}, {0, 0}}},
+		orb.Ring{{0, 0}, {0, 4}, {2, 2}, {4, 4}, {4, 0}, {0, 0}},
+	} {
+		p := PointOnSurface(g)
+		if DistanceFrom(g, p) == 0 {
+			t.Errorf("point should not be on the boundary: %v", p)
+		}
+
+		var poly orb.Polygon
+		switch g := g.(type) {
+		case orb.Ring:
+			poly = orb.Polygon{g}
+		case orb.Polygon:
+			poly = g
+		}
+
+		if !PolygonContains(poly, p) {
+			t.Errorf("point should be inside: %v", p)
+		}
+	}
+}
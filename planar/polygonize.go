@@ -0,0 +1,251 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/segments"
+)
+
+// Polygonize returns the polygons formed by the lines. The lines must be
+// fully noded, i.e. they can only touch or cross at their vertices. Every
+// segment of the lines is an edge, duplicates are ignored. Dangling edges,
+// and the cut edges that connect two otherwise separate rings, are not
+// part of any polygon and are ignored. Each face enclosed by the edges is
+// a counter-clockwise polygon with clockwise holes for any separate groups
+// of edges inside it. Polygons for neighboring faces share edges, and the
+// polygons inside a hole are also returned.
+func Polygonize(lines orb.MultiLineString) orb.MultiPolygon {
+	var edges [][2]orb.Point
+	seen := make(map[[2]orb.Point]bool)
+	for _, ls := range lines {
+		for i := 1; i < len(ls); i++ {
+			if ls[i-1] == ls[i] {
+				continue
+			}
+
+			key, _ := edgeKey(ls[i-1], ls[i])
+			if !seen[key] {
+				seen[key] = true
+				edges = append(edges, [2]orb.Point{ls[i-1], ls[i]})
+			}
+		}
+	}
+
+	removed := make([]bool, len(edges))
+	for {
+		removeDangles(edges, removed)
+
+		faces := traceFaces(edges, removed)
+
+		// a cut edge has the same face on both sides
+		cut := false
+		for _, f := range faces {
+			for _, he := range f.halfEdges {
+				if he%2 == 0 && f.has[he+1] {
+					removed[he/2] = true
+					cut = true
+				}
+			}
+		}
+
+		if !cut {
+			return assembleFaces(edges, removed, faces)
+		}
+	}
+}
+
+// removeDangles removes the edges with an end that has no other edges.
+func removeDangles(edges [][2]orb.Point, removed []bool) {
+	degree := make(map[orb.Point]int)
+	incident := make(map[orb.Point][]int)
+	for i, e := range edges {
+		if removed[i] {
+			continue
+		}
+
+		for _, p := range e {
+			degree[p]++
+			incident[p] = append(incident[p], i)
+		}
+	}
+
+	var queue []orb.Point
+	for p, d := range degree {
+		if d == 1 {
+			queue = append(queue, p)
+		}
+	}
+
+	for len(queue) > 0 {
+		p := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+
+		for _, i := range incident[p] {
+			if removed[i] {
+				continue
+			}
+			removed[i] = true
+
+			for _, q := range edges[i] {
+				degree[q]--
+				if q != p && degree[q] == 1 {
+					queue = append(queue, q)
+				}
+			}
+		}
+	}
+}
+
+type face struct {
+	ring      orb.Ring
+	halfEdges []int
+	has       map[int]bool
+}
+
+// traceFaces returns the boundary of every face of the edges. Half-edge
+// 2*i goes from the first to the second point of edge i and 2*i+1 is the
+// reverse. Taking the leftmost turn at every point traces bounded faces
+// counter-clockwise and the outside of each group of edges clockwise.
+func traceFaces(edges [][2]orb.Point, removed []bool) []*face {
+	from := func(he int) orb.Point { return edges[he/2][he%2] }
+	to := func(he int) orb.Point { return edges[he/2][1-he%2] }
+
+	outgoing := make(map[orb.Point][]int)
+	for i := range edges {
+		if !removed[i] {
+			outgoing[edges[i][0]] = append(outgoing[edges[i][0]], 2*i)
+			outgoing[edges[i][1]] = append(outgoing[edges[i][1]], 2*i+1)
+		}
+	}
+
+	next := func(he int) int {
+		a, b := from(he), to(he)
+
+		result := he ^ 1
+		best := math.Inf(-1)
+		for _, o := range outgoing[b] {
+			if o == he^1 {
+				continue
+			}
+
+			c := to(o)
+			turn := math.Atan2(
+				segments.Orient(a, b, c),
+				(b[0]-a[0])*(c[0]-b[0])+(b[1]-a[1])*(c[1]-b[1]),
+			)
+			if turn > best {
+				best = turn
+				result = o
+			}
+		}
+
+		return result
+	}
+
+	visited := make([]bool, 2*len(edges))
+	var faces []*face
+	for i := range edges {
+		if removed[i] {
+			continue
+		}
+
+		for _, start := range []int{2 * i, 2*i + 1} {
+			if visited[start] {
+				continue
+			}
+
+			f := &face{has: make(map[int]bool)}
+			f.ring = orb.Ring{from(start)}
+			for he := start; !visited[he]; he = next(he) {
+				visited[he] = true
+				f.halfEdges = append(f.halfEdges, he)
+				f.has[he] = true
+				f.ring = append(f.ring, to(he))
+			}
+
+			faces = append(faces, f)
+		}
+	}
+
+	return faces
+}
+
+// assembleFaces returns the counter-clockwise faces as polygons with the
+// clockwise outer boundaries of the groups of edges inside them as holes.
+func assembleFaces(edges [][2]orb.Point, removed []bool, faces []*face) orb.MultiPolygon {
+	// groups of connected edges
+	parent := make(map[orb.Point]orb.Point)
+	var find func(p orb.Point) orb.Point
+	find = func(p orb.Point) orb.Point {
+		if q, ok := parent[p]; ok && q != p {
+			r := find(q)
+			parent[p] = r
+			return r
+		}
+		return p
+	}
+
+	for i, e := range edges {
+		if !removed[i] {
+			parent[find(e[0])] = find(e[1])
+		}
+	}
+
+	type shell struct {
+		area  float64
+		bound orb.Bound
+		group orb.Point
+		index int
+	}
+
+	var (
+		result orb.MultiPolygon
+		shells []shell
+		holes  []orb.Ring
+	)
+
+	for _, f := range faces {
+		_, area := ringCentroidArea(f.ring)
+		if area > 0 {
+			shells = append(shells, shell{
+				area:  area,
+				bound: f.ring.Bound(),
+				group: find(f.ring[0]),
+				index: len(result),
+			})
+			result = append(result, orb.Polygon{f.ring})
+		} else if area < 0 {
+			holes = append(holes, f.ring)
+		}
+	}
+
+	for _, h := range holes {
+		// separate groups of edges do not touch
+		// so any point is inside or outside.
+		p := h[0]
+		group := find(p)
+
+		best := -1
+		for i, s := range shells {
+			if s.group == group || !s.bound.Contains(p) {
+				continue
+			}
+
+			if best != -1 && shells[best].area <= s.area {
+				continue
+			}
+
+			if RingContains(result[s.index][0], p) {
+				best = i
+			}
+		}
+
+		if best != -1 {
+			idx := shells[best].index
+			result[idx] = append(result[idx], h)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,110 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestPolygonize(t *testing.T) {
+	cases := []struct {
+		name   string
+		lines  orb.MultiLineString
+		result orb.MultiPolygon
+	}{
+		{
+			name:   "empty",
+			lines:  orb.MultiLineString{},
+			result: nil,
+		},
+		{
+			name:   "square from segments",
+			lines:  orb.MultiLineString{{{0, 0}, {1, 0}}, {{1, 1}, {1, 0}}, {{0, 1}, {1, 1}}, {{0, 0}, {0, 1}}},
+			result: orb.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}},
+		},
+		{
+			name: "two faces",
+			lines: orb.MultiLineString{
+				{{0, 0}, {1, 0}, {2, 0}, {2, 1}, {1, 1}, {0, 1}, {0, 0}},
+				{{1, 0}, {1, 1}},
+			},
+			result: orb.MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+				{{{1, 0}, {2, 0}, {2, 1}, {1, 1}, {1, 0}}},
+			},
+		},
+		{
+			name:   "open line",
+			lines:  orb.MultiLineString{{{0, 0}, {1, 0}, {1, 1}}},
+			result: nil,
+		},
+		{
+			name: "dangle",
+			lines: orb.MultiLineString{
+				{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}},
+				{{1, 1}, {2, 2}, {3, 2}},
+			},
+			result: orb.MultiPolygon{{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}}},
+		},
+		{
+			name: "cut edge",
+			lines: orb.MultiLineString{
+				{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}},
+				{{1, 0}, {3, 0}},
+				{{3, 0}, {4, 0}, {4, 1}, {3, 1}, {3, 0}},
+			},
+			result: orb.MultiPolygon{
+				{{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}}},
+				{{{3, 0}, {4, 0}, {4, 1}, {3, 1}, {3, 0}}},
+			},
+		},
+		{
+			name: "hole with island",
+			lines: orb.MultiLineString{
+				{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+				{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}},
+			},
+			result: orb.MultiPolygon{
+				{
+					{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+					{{3, 1}, {1, 1}, {1, 3}, {3, 3}, {3, 1}},
+				},
+				{{{1, 1}, {3, 1}, {3, 3}, {1, 3}, {1, 1}}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := Polygonize(tc.lines)
+
+			if !result.Equal(tc.result) {
+				t.Errorf("incorrect polygons: %v != %v", result, tc.result)
+			}
+		})
+	}
+}
+
+func TestPolygonize_area(t *testing.T) {
+	// a grid of 3x3 cells
+	var lines orb.MultiLineString
+	for i := 0.0; i <= 3; i++ {
+		lines = append(lines, orb.LineString{{0, i}, {1, i}, {2, i}, {3, i}})
+		lines = append(lines, orb.LineString{{i, 0}, {i, 1}, {i, 2}, {i, 3}})
+	}
+
+	result := Polygonize(lines)
+	if len(result) != 9 {
+		t.Errorf("incorrect number of polygons: %d", len(result))
+	}
+
+	for _, p := range result {
+		if a := Area(p); a != 1 {
+			t.Errorf("incorrect area: %v", a)
+		}
+
+		if p[0].Orientation() != orb.CCW {
+			t.Errorf("should be counter-clockwise: %v", p)
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package planar
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// Polylabel returns the pole of inaccessibility of the polygon, the interior
+// point farthest from the boundary, and its distance to the boundary.
+// It is a better place for a label than the centroid which can be outside
+// concave polygons. The search stops when the distance can not be improved
+// by more than the precision, in the units of the polygon. The precision
+// must be greater than zero. Polygons that are not thicker than the precision
+// return the PointOnSurface and its distance to the boundary.
+// This is a port of https://github.com/mapbox/polylabel
+func Polylabel(p orb.Polygon, precision float64) (orb.Point, float64) {
+	if precision <= 0 {
+		panic("precision must be greater than zero")
+	}
+
+	if len(p) == 0 || len(p[0]) == 0 {
+		return orb.Point{}, 0
+	}
+
+	b := p[0].Bound()
+	size := math.Min(b.Max[0]-b.Min[0], b.Max[1]-b.Min[1])
+	if size == 0 {
+		return b.Min, 0
+	}
+
+	// the grid can not improve on any point by more than the precision
+	// and would need a huge number of cells to cover these polygons.
+	if size <= precision {
+		c := PointOnSurface(p)
+		return c, newLabelCell(p, c, 0).d
+	}
+
+	queue := &labelCellQueue{}
+	h := size / 2
+	for x := b.Min[0]; x < b.Max[0]; x += size {
+		for y := b.Min[1]; y < b.Max[1]; y += size {
+			queue.cells = append(queue.cells, newLabelCell(p, orb.Point{x + h, y + h}, h))
+		}
+	}
+	heap.Init(queue)
+
+	// the centroid is a good first guess, for rectangles it is the answer
+	c, _ := CentroidArea(p[0])
+	best := newLabelCell(p, c, 0)
+	if center := newLabelCell(p, b.Center(), 0); center.d > best.d {
+		best = center
+	}
+
+	for queue.Len() > 0 {
+		cell := heap.Pop(queue).(labelCell)
+		if cell.d > best.d {
+			best = cell
+		}
+
+		// no better solution in this cell
+		if cell.max-best.d <= precision {
+			continue
+		}
+
+		h := cell.h / 2
+		for _, d := range [4][2]float64{{-h, -h}, {h, -h}, {-h, h}, {h, h}} {
+			point := orb.Point{cell.center[0] + d[0], cell.center[1] + d[1]}
+			heap.Push(queue, newLabelCell(p, point, h))
+		}
+	}
+
+	return best.center, best.d
+}
+
+type labelCell struct {
+	center orb.Point
+	h      float64 // half the cell size
+	d      float64 // distance to the polygon boundary, negative if outside
+	max    float64 // max distance of any point in the cell
+}
+
+func newLabelCell(p orb.Polygon, center orb.Point, h float64) labelCell {
+	d, _ := polygonDistanceFrom(p, center)
+	if !PolygonContains(p, center) {
+		d = -d
+	}
+
+	return labelCell{
+		center: center,
+		h:      h,
+		d:      d,
+		max:    d + h*math.Sqrt2,
+	}
+}
+
+// labelCellQueue is a max heap of the cells by the max possible distance.
+type labelCellQueue struct {
+	cells []labelCell
+}
+
+func (q *labelCellQueue) Len() int           { return len(q.cells) }
+func (q *labelCellQueue) Less(i, j int) bool { return q.cells[i].max > q.cells[j].max }
+func (q *labelCellQueue) Swap(i, j int)      { q.cells[i], q.cells[j] = q.cells[j], q.cells[i] }
+func (q *labelCellQueue) Push(x interface{}) { q.cells = append(q.cells, x.(labelCell)) }
+func (q *labelCellQueue) Pop() interface{} {
+	c := q.cells[len(q.cells)-1]
+	q.cells = q.cells[:len(q.cells)-1]
+	return c
+}
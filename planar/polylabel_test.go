@@ -0,0 +1,145 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestPolylabel(t *testing.T) {
+	// the centroid of this shape is outside
+	u := orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {7, 10}, {7, 3}, {3, 3}, {3, 10}, {0, 10}, {0, 0}}}
+
+	cases := []struct {
+		name     string
+		polygon  orb.Polygon
+		point    orb.Point
+		distance float64
+	}{
+		{
+			name:     "square",
+			polygon:  orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			point:    orb.Point{5, 5},
+			distance: 5,
+		},
+		{
+			name:     "rectangle",
+			polygon:  orb.Polygon{{{0, 0}, {10, 0}, {10, 2}, {0, 2}, {0, 0}}},
+			point:    orb.Point{5, 1},
+			distance: 1,
+		},
+		{
+			name:     "u shape",
+			polygon:  u,
+			distance: 3 * math.Sqrt2 / (1 + math.Sqrt2),
+		},
+		{
+			name: "square with hole",
+			polygon: orb.Polygon{
+				{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+				{{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}},
+			},
+			distance: 2 * math.Sqrt2 / (1 + math.Sqrt2),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, d := Polylabel(tc.polygon, 0.001)
+			if math.Abs(d-tc.distance) > 0.001 {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if !PolygonContains(tc.polygon, p) {
+				t.Errorf("point should be inside: %v", p)
+			}
+
+			if e := DistanceFrom(tc.polygon, p); math.Abs(e-d) > 1e-9 {
+				t.Errorf("distance does not match point: %v != %v", d, e)
+			}
+
+			if tc.point != (orb.Point{}) && !tc.point.Equal(p) {
+				t.Errorf("incorrect point: %v != %v", p, tc.point)
+			}
+		})
+	}
+}
+
+func TestPolylabel_degenerate(t *testing.T) {
+	cases := []struct {
+		name    string
+		polygon orb.Polygon
+		point   orb.Point
+	}{
+		{
+			name:    "empty",
+			polygon: orb.Polygon{},
+			point:   orb.Point{},
+		},
+		{
+			name:    "line",
+			polygon: orb.Polygon{{{1, 1}, {5, 1}, {1, 1}}},
+			point:   orb.Point{1, 1},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, d := Polylabel(tc.polygon, 1)
+			if !p.Equal(tc.point) || d != 0 {
+				t.Errorf("incorrect label: %v %v", p, d)
+			}
+		})
+	}
+}
+
+func TestPolylabel_thin(t *testing.T) {
+	cases := []struct {
+		name      string
+		polygon   orb.Polygon
+		precision float64
+	}{
+		{
+			name:      "thinner than the precision",
+			polygon:   orb.Polygon{{{1, 1}, {1e6, 1}, {1e6, 1 + 1e-9}, {1, 1 + 1e-9}, {1, 1}}},
+			precision: 1,
+		},
+		{
+			name:      "precision equals the side length",
+			polygon:   orb.Polygon{{{0, 0}, {10, 0}, {10, 2}, {0, 2}, {0, 0}}},
+			precision: 2,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p, d := Polylabel(tc.polygon, tc.precision)
+			if !PolygonContains(tc.polygon, p) {
+				t.Errorf("point should be inside: %v", p)
+			}
+
+			if d <= 0 {
+				t.Errorf("distance should be positive: %v", d)
+			}
+
+			if e := DistanceFrom(tc.polygon, p); math.Abs(e-d) > 1e-9 {
+				t.Errorf("distance does not match point: %v != %v", d, e)
+			}
+		})
+	}
+}
+
+func TestPolylabel_precision(t *testing.T) {
+	for _, precision := range []float64{0, -1} {
+		func() {
+			defer func() {
+				if recover() == nil {
+					t.Errorf("should panic for precision %v", precision)
+				}
+			}()
+
+			Polylabel(orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}, precision)
+		}()
+	}
+}
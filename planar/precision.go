@@ -0,0 +1,402 @@
+package planar
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// PrecisionModel reduces the precision of coordinates by snapping them
+// to a regular grid. Unlike orb.Round, which rounds every coordinate on
+// its own, the geometry is snap rounded so the result stays valid.
+type PrecisionModel struct {
+	// Scale is the number of grid cells per unit. Coordinates are rounded
+	// to multiples of 1/Scale, e.g. 1000 keeps 3 decimal places.
+	// A zero, negative or infinite scale means no rounding, the full
+	// float64 precision is kept.
+	Scale float64
+}
+
+// FixedPrecision returns a precision model with the given distance
+// between the grid lines. A grid size of zero or less means no rounding.
+func FixedPrecision(gridSize float64) PrecisionModel {
+	if gridSize <= 0 {
+		return PrecisionModel{}
+	}
+
+	return PrecisionModel{Scale: 1 / gridSize}
+}
+
+// DecimalPrecision returns a precision model that keeps the given number
+// of decimal places. Negative values round to tens, hundreds and so on.
+func DecimalPrecision(decimals int) PrecisionModel {
+	return PrecisionModel{Scale: math.Pow10(decimals)}
+}
+
+// GridSize returns the distance between the grid lines.
+// It is zero if the model does no rounding.
+func (pm PrecisionModel) GridSize() float64 {
+	if pm.floating() {
+		return 0
+	}
+
+	return 1 / pm.Scale
+}
+
+// Point returns the point rounded to the nearest grid point.
+func (pm PrecisionModel) Point(p orb.Point) orb.Point {
+	if pm.floating() {
+		return p
+	}
+
+	return orb.Point{pm.round(p[0]), pm.round(p[1])}
+}
+
+// floating returns true if the scale does not define a grid,
+// i.e. the coordinates are not rounded.
+func (pm PrecisionModel) floating() bool {
+	return !(pm.Scale > 0) || math.IsInf(pm.Scale, 1)
+}
+
+func (pm PrecisionModel) round(v float64) float64 {
+	if pm.Scale < 1 {
+		// a grid larger than one, dividing by an integer size is exact
+		s := 1 / pm.Scale
+		return math.Round(v/s) * s
+	}
+
+	return math.Round(v*pm.Scale) / pm.Scale
+}
+
+// Apply returns a copy of the geometry with every coordinate on the grid.
+// It uses snap rounding: the vertices and the intersections of the
+// segments are rounded to grid points and every segment that passes through
+// one of these grid cells gets a vertex there. So rounding will not create
+// new crossings. Lines that collapse to a point are removed. Polygons are
+// rebuilt from the snapped rings so collapsed rings, spikes and edges that
+// end up on top of each other are removed.
+//
+// Polygons and rings that are split into several parts are returned as
+// a MultiPolygon. A geometry that collapses completely is returned as
+// a nil value of the same type. The input is not modified.
+// If the model does no rounding a copy of the geometry is returned.
+func (pm PrecisionModel) Apply(g orb.Geometry) orb.Geometry {
+	if g == nil {
+		return nil
+	}
+
+	if pm.floating() {
+		return orb.Clone(g)
+	}
+
+	segs := appendGeometrySegments(nil, g)
+	sr := &snapRounder{
+		pm:      pm,
+		snapped: pm.snapSegments(segs),
+	}
+
+	return sr.geometry(g)
+}
+
+// appendGeometrySegments adds the segments of all the lines and rings
+// of the geometry in order. Rings are implicitly closed.
+func appendGeometrySegments(segs []segment, g orb.Geometry) []segment {
+	switch g := g.(type) {
+	case nil, orb.Point, orb.MultiPoint, orb.Bound:
+		return segs
+	case orb.LineString:
+		return appendLineSegments(segs, g)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			segs = appendLineSegments(segs, ls)
+		}
+		return segs
+	case orb.Ring:
+		return appendLineSegments(segs, closeRing(g))
+	case orb.Polygon:
+		for _, r := range g {
+			segs = appendLineSegments(segs, closeRing(r))
+		}
+		return segs
+	case orb.MultiPolygon:
+		for _, p := range g {
+			for _, r := range p {
+				segs = appendLineSegments(segs, closeRing(r))
+			}
+		}
+		return segs
+	case orb.Collection:
+		for _, c := range g {
+			segs = appendGeometrySegments(segs, c)
+		}
+		return segs
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return appendGeometrySegments(segs, zm.ToXY(g))
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+func appendLineSegments(segs []segment, ls []orb.Point) []segment {
+	for i := 1; i < len(ls); i++ {
+		segs = append(segs, segment{a: ls[i-1], b: ls[i]})
+	}
+
+	return segs
+}
+
+// closeRing returns the ring with the first point added to the end if needed.
+func closeRing(r orb.Ring) orb.Ring {
+	if len(r) == 0 || r[0] == r[len(r)-1] {
+		return r
+	}
+
+	return append(r[:len(r):len(r)], r[0])
+}
+
+// snapSegments returns every segment as a line through the hot pixels it
+// passes through. The hot pixels are the grid cells that contain
+// a vertex or an intersection of two segments.
+func (pm PrecisionModel) snapSegments(segs []segment) [][]orb.Point {
+	if len(segs) == 0 {
+		return nil
+	}
+
+	var hot []orb.Point
+	seen := make(map[orb.Point]bool)
+	addHot := func(p orb.Point) {
+		p = pm.Point(p)
+		if !seen[p] {
+			seen[p] = true
+			hot = append(hot, p)
+		}
+	}
+
+	for _, s := range segs {
+		addHot(s.a)
+		addHot(s.b)
+	}
+
+	forEachIntersection(segs, func(i, j int, points []orb.Point) {
+		for _, p := range points {
+			addHot(p)
+		}
+	})
+
+	// pad the bounds by half a cell so querying with the center of
+	// a hot pixel finds all the segments that may pass through it.
+	half := pm.GridSize() / 2
+	bounds := make([]orb.Bound, len(segs))
+	for i, s := range segs {
+		bounds[i] = s.bound().Pad(half)
+	}
+
+	type hit struct {
+		p orb.Point
+		t float64
+	}
+
+	hits := make([][]hit, len(segs))
+	forEachContained(bounds, hot, func(i int, p orb.Point) {
+		if t, ok := pixelEntry(segs[i].a, segs[i].b, p, half); ok {
+			hits[i] = append(hits[i], hit{p: p, t: t})
+		}
+	})
+
+	result := make([][]orb.Point, len(segs))
+	for i, s := range segs {
+		sort.SliceStable(hits[i], func(j, k int) bool {
+			return hits[i][j].t < hits[i][k].t
+		})
+
+		line := []orb.Point{pm.Point(s.a)}
+		for _, h := range hits[i] {
+			if h.p != line[len(line)-1] {
+				line = append(line, h.p)
+			}
+		}
+
+		if end := pm.Point(s.b); end != line[len(line)-1] {
+			line = append(line, end)
+		}
+
+		result[i] = line
+	}
+
+	return result
+}
+
+// pixelEntry returns where the segment [a, b] enters the square grid cell
+// centered at c, as a fraction along the segment. Returns false if the
+// segment does not touch the cell.
+func pixelEntry(a, b, c orb.Point, half float64) (float64, bool) {
+	t0, t1 := 0.0, 1.0
+	for axis := 0; axis < 2; axis++ {
+		d := b[axis] - a[axis]
+		lo := c[axis] - half - a[axis]
+		hi := c[axis] + half - a[axis]
+
+		if d == 0 {
+			if lo > 0 || hi < 0 {
+				return 0, false
+			}
+			continue
+		}
+
+		u0, u1 := lo/d, hi/d
+		if u0 > u1 {
+			u0, u1 = u1, u0
+		}
+
+		t0 = math.Max(t0, u0)
+		t1 = math.Min(t1, u1)
+		if t0 > t1 {
+			return 0, false
+		}
+	}
+
+	return t0, true
+}
+
+// snapRounder rebuilds a geometry from its snapped segments.
+// The segments are consumed in the order of appendGeometrySegments.
+type snapRounder struct {
+	pm      PrecisionModel
+	snapped [][]orb.Point
+	next    int
+}
+
+func (sr *snapRounder) geometry(g orb.Geometry) orb.Geometry {
+	switch g := g.(type) {
+	case nil:
+		return nil
+	case orb.Point:
+		return sr.pm.Point(g)
+	case orb.MultiPoint:
+		if g == nil {
+			return g
+		}
+
+		// points that snap together are merged
+		result := make(orb.MultiPoint, 0, len(g))
+		seen := make(map[orb.Point]bool, len(g))
+		for _, p := range g {
+			p = sr.pm.Point(p)
+			if !seen[p] {
+				seen[p] = true
+				result = append(result, p)
+			}
+		}
+		return result
+	case orb.LineString:
+		return sr.lineString(g)
+	case orb.MultiLineString:
+		var result orb.MultiLineString
+		for _, ls := range g {
+			if l := sr.lineString(ls); l != nil {
+				result = append(result, l)
+			}
+		}
+		return result
+	case orb.Ring:
+		mp := sr.polygons([]orb.Polygon{{g}})
+		if len(mp) == 0 {
+			return orb.Ring(nil)
+		}
+
+		if len(mp) == 1 && len(mp[0]) == 1 {
+			return mp[0][0]
+		}
+		return mp
+	case orb.Polygon:
+		mp := sr.polygons([]orb.Polygon{g})
+		if len(mp) == 0 {
+			return orb.Polygon(nil)
+		}
+
+		if len(mp) == 1 {
+			return mp[0]
+		}
+		return mp
+	case orb.MultiPolygon:
+		return sr.polygons(g)
+	case orb.Collection:
+		if g == nil {
+			return g
+		}
+
+		result := make(orb.Collection, 0, len(g))
+		for _, c := range g {
+			result = append(result, sr.geometry(c))
+		}
+		return result
+	case orb.Bound:
+		return orb.Bound{Min: sr.pm.Point(g.Min), Max: sr.pm.Point(g.Max)}
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return sr.geometry(zm.ToXY(g))
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+// line joins the snapped segments of the next line of the geometry.
+func (sr *snapRounder) line(ls []orb.Point) []orb.Point {
+	var result []orb.Point
+	for i := 1; i < len(ls); i++ {
+		for _, p := range sr.snapped[sr.next] {
+			if len(result) == 0 || result[len(result)-1] != p {
+				result = append(result, p)
+			}
+		}
+		sr.next++
+	}
+
+	return result
+}
+
+// lineString returns nil if the line collapses to a point.
+func (sr *snapRounder) lineString(ls orb.LineString) orb.LineString {
+	result := sr.line(ls)
+	if len(result) < 2 {
+		return nil
+	}
+
+	return result
+}
+
+// polygons rebuilds the snapped polygons. Outer rings are made
+// counter-clockwise and holes clockwise, based on the orientation before
+// snapping, so the union of the snapped edges is the snapped area.
+func (sr *snapRounder) polygons(ps []orb.Polygon) orb.MultiPolygon {
+	var segs []segment
+	for _, p := range ps {
+		for i, r := range p {
+			ring := orb.Ring(sr.line(closeRing(r)))
+			if len(ring) < 4 {
+				continue
+			}
+
+			o := r.Orientation()
+			if o == 0 {
+				continue
+			}
+
+			if (i == 0) != (o == orb.CCW) {
+				ring.Reverse()
+			}
+
+			for j := 1; j < len(ring); j++ {
+				segs = append(segs, segment{a: ring[j-1], b: ring[j]})
+			}
+		}
+	}
+
+	return overlaySegments(segs, unionOp)
+}
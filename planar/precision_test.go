@@ -0,0 +1,277 @@
+package planar
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestPrecisionModel_Point(t *testing.T) {
+	cases := []struct {
+		name     string
+		pm       PrecisionModel
+		point    orb.Point
+		expected orb.Point
+	}{
+		{
+			name:     "decimals",
+			pm:       DecimalPrecision(2),
+			point:    orb.Point{1.23456, -9.87654},
+			expected: orb.Point{1.23, -9.88},
+		},
+		{
+			name:     "negative decimals",
+			pm:       DecimalPrecision(-2),
+			point:    orb.Point{1234, -5678},
+			expected: orb.Point{1200, -5700},
+		},
+		{
+			name:     "grid size",
+			pm:       FixedPrecision(0.5),
+			point:    orb.Point{1.3, 1.2},
+			expected: orb.Point{1.5, 1},
+		},
+		{
+			name:     "large grid size",
+			pm:       FixedPrecision(10),
+			point:    orb.Point{14, 16},
+			expected: orb.Point{10, 20},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := tc.pm.Point(tc.point)
+			if !p.Equal(tc.expected) {
+				t.Errorf("incorrect point: %v != %v", p, tc.expected)
+			}
+		})
+	}
+}
+
+func TestPrecisionModel_GridSize(t *testing.T) {
+	if v := FixedPrecision(0.25).GridSize(); v != 0.25 {
+		t.Errorf("incorrect grid size: %v", v)
+	}
+
+	if v := DecimalPrecision(3).GridSize(); v != 0.001 {
+		t.Errorf("incorrect grid size: %v", v)
+	}
+}
+
+func TestPrecisionModel_noRounding(t *testing.T) {
+	models := []PrecisionModel{
+		{Scale: 0},
+		{Scale: -1},
+		{Scale: math.Inf(1)},
+		FixedPrecision(0),
+		FixedPrecision(-1),
+	}
+
+	ls := orb.LineString{{0.123456789, 1.5}, {-2.25, 1e-300}}
+	for _, pm := range models {
+		if v := pm.GridSize(); v != 0 {
+			t.Errorf("incorrect grid size: %v", v)
+		}
+
+		for _, p := range ls {
+			if v := pm.Point(p); !v.Equal(p) {
+				t.Errorf("point should not change: %v != %v", v, p)
+			}
+		}
+
+		if v := pm.Apply(ls); !orb.Equal(v, ls) {
+			t.Errorf("geometry should not change: %v", v)
+		}
+	}
+}
+
+func TestPrecisionModel_Apply(t *testing.T) {
+	pm := FixedPrecision(1)
+
+	cases := []struct {
+		name     string
+		input    orb.Geometry
+		expected orb.Geometry
+	}{
+		{
+			name:     "nil",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "point",
+			input:    orb.Point{1.4, 1.6},
+			expected: orb.Point{1, 2},
+		},
+		{
+			name:     "multi point merges points",
+			input:    orb.MultiPoint{{1.4, 1.6}, {0, 0}, {1.2, 2.1}},
+			expected: orb.MultiPoint{{1, 2}, {0, 0}},
+		},
+		{
+			name:     "line string",
+			input:    orb.LineString{{0.2, 0.1}, {5.1, 4.9}, {9.7, 0.3}},
+			expected: orb.LineString{{0, 0}, {5, 5}, {10, 0}},
+		},
+		{
+			name:     "collapsed line string",
+			input:    orb.LineString{{0.1, 0.1}, {0.2, 0.3}},
+			expected: orb.LineString(nil),
+		},
+		{
+			name: "line snaps to nearby vertices",
+			input: orb.MultiLineString{
+				{{0, 0}, {10, 0}},
+				{{5, 0.4}, {6, 0.4}},
+			},
+			expected: orb.MultiLineString{
+				{{0, 0}, {5, 0}, {6, 0}, {10, 0}},
+				{{5, 0}, {6, 0}},
+			},
+		},
+		{
+			name: "crossing gets a vertex",
+			input: orb.MultiLineString{
+				{{0, 0}, {10, 10}},
+				{{0, 6.1}, {10, 4.1}},
+			},
+			expected: orb.MultiLineString{
+				{{0, 0}, {5, 5}, {10, 10}},
+				{{0, 6}, {5, 5}, {10, 4}},
+			},
+		},
+		{
+			name: "collapsed lines are removed",
+			input: orb.MultiLineString{
+				{{0, 0}, {0.2, 0.2}},
+				{{0, 0}, {2, 2}},
+			},
+			expected: orb.MultiLineString{
+				{{0, 0}, {2, 2}},
+			},
+		},
+		{
+			name:     "collapsed polygon",
+			input:    orb.Polygon{{{0, 0}, {10, 0}, {10, 0.2}, {0, 0.2}, {0, 0}}},
+			expected: orb.Polygon(nil),
+		},
+		{
+			name:     "collapsed ring",
+			input:    orb.Ring{{0, 0}, {0.4, 0}, {0.4, 0.4}, {0, 0}},
+			expected: orb.Ring(nil),
+		},
+		{
+			name:     "bound",
+			input:    orb.Bound{Min: orb.Point{0.2, 0.7}, Max: orb.Point{3.6, 4.4}},
+			expected: orb.Bound{Min: orb.Point{0, 1}, Max: orb.Point{4, 4}},
+		},
+		{
+			name: "collection",
+			input: orb.Collection{
+				orb.Point{1.4, 1.6},
+				orb.LineString{{0.1, 0.1}, {0.2, 0.3}},
+			},
+			expected: orb.Collection{
+				orb.Point{1, 2},
+				orb.LineString(nil),
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := pm.Apply(tc.input)
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("incorrect result")
+				t.Logf("%v", result)
+				t.Logf("%v", tc.expected)
+			}
+		})
+	}
+}
+
+func TestPrecisionModel_Apply_polygons(t *testing.T) {
+	pm := FixedPrecision(1)
+
+	t.Run("hole snaps onto the outer ring", func(t *testing.T) {
+		p := orb.Polygon{
+			{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+			{{1, 0.3}, {1, 2}, {2, 2}, {2, 0.3}, {1, 0.3}},
+		}
+
+		result, ok := pm.Apply(p).(orb.Polygon)
+		if !ok {
+			t.Fatalf("should be a polygon: %T", pm.Apply(p))
+		}
+
+		if len(result) != 1 {
+			t.Errorf("hole should be merged into the outer ring: %v", result)
+		}
+
+		if a := Area(result); a != 98 {
+			t.Errorf("incorrect area: %v", a)
+		}
+	})
+
+	t.Run("neck collapses", func(t *testing.T) {
+		p := orb.Polygon{{
+			{0, 0}, {3, 0}, {3, 1.4}, {4, 1.4}, {4, 0}, {7, 0},
+			{7, 3}, {4, 3}, {4, 1.45}, {3, 1.45}, {3, 3}, {0, 3}, {0, 0},
+		}}
+
+		result, ok := pm.Apply(p).(orb.MultiPolygon)
+		if !ok {
+			t.Fatalf("should be a multi polygon: %T", pm.Apply(p))
+		}
+
+		if len(result) != 2 {
+			t.Fatalf("should split into 2 polygons: %v", result)
+		}
+
+		for _, p := range result {
+			if a := Area(p); a != 9 {
+				t.Errorf("incorrect area: %v", a)
+			}
+		}
+	})
+
+	t.Run("does not modify the input", func(t *testing.T) {
+		p := orb.Polygon{{{0.1, 0.1}, {5.2, 0.1}, {5.2, 5.3}, {0.1, 0.1}}}
+		expected := p.Clone()
+
+		result := pm.Apply(p)
+		if !p.Equal(expected) {
+			t.Errorf("input modified: %v", p)
+		}
+
+		if r, ok := result.(orb.Polygon); !ok || r[0].Orientation() != orb.CCW {
+			t.Errorf("should be a ccw polygon: %v", result)
+		}
+	})
+
+	t.Run("rounding does not create crossings", func(t *testing.T) {
+		// a narrow wedge with a vertex close to the opposite edge,
+		// rounding each coordinate on its own crosses the edges.
+		p := orb.Polygon{{
+			{0, 0}, {20, 0}, {20, 2.2}, {10.4, 0.6}, {0, 2.2}, {0, 0},
+		}}
+
+		result := pm.Apply(p)
+		area := Area(result)
+		if area <= 0 || math.Abs(area-Area(p)) > 20 {
+			t.Errorf("incorrect area: %v", area)
+		}
+
+		segs := appendAreaSegments(nil, result, 0)
+		forEachIntersection(segs, func(i, j int, points []orb.Point) {
+			for _, pt := range points {
+				if pt != segs[i].a && pt != segs[i].b {
+					t.Errorf("segments cross at %v", pt)
+				}
+			}
+		})
+	})
+}
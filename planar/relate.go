@@ -0,0 +1,427 @@
+package planar
+
+import (
+	"fmt"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// Location is the position of a point relative to a geometry
+// as defined by the Dimensionally Extended 9-Intersection Model.
+type Location int
+
+// Constants for the three possible locations.
+const (
+	Interior Location = 0
+	Boundary Location = 1
+	Exterior Location = 2
+)
+
+// IntersectionMatrix is a DE-9IM matrix describing the relationship
+// between two geometries. The rows are the Interior, Boundary and Exterior
+// of the first geometry and the columns the same for the second geometry.
+// The values are the dimension of the intersection, or -1 if it is empty.
+type IntersectionMatrix [3][3]int
+
+// Get returns the dimension of the intersection of the two locations.
+// It will return -1 if the intersection is empty.
+func (im IntersectionMatrix) Get(a, b Location) int {
+	return im[a][b]
+}
+
+// Transpose returns the matrix for the relationship with
+// the geometries in the other order.
+func (im IntersectionMatrix) Transpose() IntersectionMatrix {
+	var t IntersectionMatrix
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			t[i][j] = im[j][i]
+		}
+	}
+
+	return t
+}
+
+// String returns the standard 9 character representation of the
+// matrix, e.g. "212101212". Empty intersections are marked with an "F".
+func (im IntersectionMatrix) String() string {
+	b := make([]byte, 0, 9)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if im[i][j] < 0 {
+				b = append(b, 'F')
+			} else {
+				b = append(b, byte('0'+im[i][j]))
+			}
+		}
+	}
+
+	return string(b)
+}
+
+// Matches checks the matrix against a 9 character DE-9IM pattern.
+// The pattern characters are 'T' for a non-empty intersection, 'F' for
+// an empty intersection, '*' for anything and '0', '1' or '2' for an
+// intersection of that exact dimension. Invalid patterns never match.
+func (im IntersectionMatrix) Matches(pattern string) bool {
+	if len(pattern) != 9 {
+		return false
+	}
+
+	for i := 0; i < 9; i++ {
+		v := im[i/3][i%3]
+		switch pattern[i] {
+		case '*':
+		case 'T', 't':
+			if v < 0 {
+				return false
+			}
+		case 'F', 'f':
+			if v >= 0 {
+				return false
+			}
+		case '0', '1', '2':
+			if v != int(pattern[i]-'0') {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func (im *IntersectionMatrix) extend(a, b Location, dim int) {
+	if im[a][b] < dim {
+		im[a][b] = dim
+	}
+}
+
+// Relate computes the DE-9IM intersection matrix of the two geometries.
+// Polygons, rings and bounds are areas, line strings are lines with
+// the mod-2 rule for boundaries and points have no boundary.
+// For collections a point is in the interior if it is in the interior of any
+// of the members, otherwise on the boundary if it is on any of the boundaries.
+func Relate(a, b orb.Geometry) IntersectionMatrix {
+	im := IntersectionMatrix{
+		{-1, -1, -1},
+		{-1, -1, -1},
+		{-1, -1, 2},
+	}
+
+	ra := newRelateGeometry(a)
+	rb := newRelateGeometry(b)
+
+	var segs []segment
+	segs = ra.appendSegments(segs, 0)
+	segs = rb.appendSegments(segs, 1)
+	segs = nodeSegments(segs)
+
+	type edge struct {
+		key  [2]orb.Point
+		line [2]bool
+	}
+
+	type node struct {
+		area, line, point [2]bool
+	}
+
+	var (
+		areaSegs []segment
+		edges    []*edge
+		keys     = make(map[[2]orb.Point]*edge, len(segs))
+		nodes    = make(map[orb.Point]*node, len(segs))
+		order    []orb.Point
+	)
+
+	getNode := func(p orb.Point) *node {
+		n := nodes[p]
+		if n == nil {
+			n = &node{}
+			nodes[p] = n
+			order = append(order, p)
+		}
+		return n
+	}
+
+	for _, s := range segs {
+		op, kind := s.tag%2, s.tag/2
+
+		if kind == relatePoint {
+			getNode(s.a).point[op] = true
+			continue
+		}
+
+		key, _ := edgeKey(s.a, s.b)
+		e := keys[key]
+		if e == nil {
+			e = &edge{key: key}
+			keys[key] = e
+			edges = append(edges, e)
+		}
+
+		if kind == relateArea {
+			areaSegs = append(areaSegs, segment{a: s.a, b: s.b, tag: op})
+			getNode(s.a).area[op] = true
+			getNode(s.b).area[op] = true
+		} else {
+			e.line[op] = true
+			getNode(s.a).line[op] = true
+			getNode(s.b).line[op] = true
+		}
+	}
+
+	g := newOverlayGraph(areaSegs)
+	for _, e := range edges {
+		oe := g.keys[e.key]
+		if oe == nil {
+			oe = &overlayEdge{a: e.key[0], b: e.key[1]}
+		}
+
+		left, right := g.windings(oe)
+
+		var loc [2]Location
+		for op := 0; op < 2; op++ {
+			switch {
+			case left[op] > 0 && right[op] > 0:
+				loc[op] = Interior
+			case e.line[op]:
+				loc[op] = Interior
+			case (left[op] > 0) != (right[op] > 0):
+				loc[op] = Boundary
+			default:
+				loc[op] = Exterior
+			}
+		}
+		im.extend(loc[0], loc[1], 1)
+
+		// the faces on either side of the boundary of an area
+		if g.keys[e.key] != nil {
+			im.extend(areaLocation(left[0]), areaLocation(left[1]), 2)
+			im.extend(areaLocation(right[0]), areaLocation(right[1]), 2)
+		}
+	}
+
+	rgs := [2]*relateGeometry{ra, rb}
+	for _, p := range order {
+		n := nodes[p]
+
+		var loc [2]Location
+		for op := 0; op < 2; op++ {
+			line := Exterior
+			if rgs[op].lineBoundary[p]%2 == 1 {
+				line = Boundary
+			} else if n.line[op] {
+				line = Interior
+			}
+
+			switch {
+			case n.point[op] || line == Interior:
+				loc[op] = Interior
+			case !n.area[op] && g.windingX(op, [2]orb.Point{}, p) > 0:
+				loc[op] = Interior
+			case line == Boundary || n.area[op]:
+				loc[op] = Boundary
+			default:
+				loc[op] = Exterior
+			}
+		}
+		im.extend(loc[0], loc[1], 0)
+	}
+
+	return im
+}
+
+func areaLocation(winding int) Location {
+	if winding > 0 {
+		return Interior
+	}
+
+	return Exterior
+}
+
+// the kinds of segments, the tag is the operand plus two times the kind.
+const (
+	relateArea  = 0
+	relateLine  = 1
+	relatePoint = 2
+)
+
+// relateGeometry is a geometry broken down into its areas, lines and points.
+type relateGeometry struct {
+	areas  orb.Collection
+	lines  orb.MultiLineString
+	points orb.MultiPoint
+
+	// lineBoundary counts the number of times a point is the
+	// endpoint of a non-closed line.
+	lineBoundary map[orb.Point]int
+}
+
+func newRelateGeometry(g orb.Geometry) *relateGeometry {
+	rg := &relateGeometry{lineBoundary: make(map[orb.Point]int)}
+	rg.add(g)
+
+	for _, ls := range rg.lines {
+		if len(ls) < 2 || ls[0] == ls[len(ls)-1] {
+			continue
+		}
+
+		rg.lineBoundary[ls[0]]++
+		rg.lineBoundary[ls[len(ls)-1]]++
+	}
+
+	return rg
+}
+
+func (rg *relateGeometry) add(g orb.Geometry) {
+	if g == nil {
+		return
+	}
+
+	switch g := g.(type) {
+	case orb.Point:
+		rg.points = append(rg.points, g)
+	case orb.MultiPoint:
+		rg.points = append(rg.points, g...)
+	case orb.LineString:
+		rg.addLine(g)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			rg.addLine(ls)
+		}
+	case orb.Ring, orb.Polygon, orb.MultiPolygon, orb.Bound:
+		rg.areas = append(rg.areas, g)
+	case orb.Collection:
+		for _, c := range g {
+			rg.add(c)
+		}
+	default:
+		if zm.LayoutOf(g) != zm.XY {
+			rg.add(zm.ToXY(g))
+			return
+		}
+
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+func (rg *relateGeometry) addLine(ls orb.LineString) {
+	if len(ls) == 0 {
+		return
+	}
+
+	if len(ls) == 1 {
+		rg.points = append(rg.points, ls[0])
+		return
+	}
+
+	rg.lines = append(rg.lines, ls)
+}
+
+func (rg *relateGeometry) appendSegments(segs []segment, op int) []segment {
+	segs = appendAreaSegments(segs, rg.areas, op+2*relateArea)
+
+	for _, ls := range rg.lines {
+		for i := 0; i < len(ls)-1; i++ {
+			segs = append(segs, segment{a: ls[i], b: ls[i+1], tag: op + 2*relateLine})
+		}
+	}
+
+	for _, p := range rg.points {
+		segs = append(segs, segment{a: p, b: p, tag: op + 2*relatePoint})
+	}
+
+	return segs
+}
+
+// Disjoint returns true if the geometries have no point in common.
+func Disjoint(a, b orb.Geometry) bool {
+	return Relate(a, b).Matches("FF*FF****")
+}
+
+// Touches returns true if the geometries have at least one point in common,
+// but their interiors do not intersect.
+func Touches(a, b orb.Geometry) bool {
+	im := Relate(a, b)
+	return im.Matches("FT*******") ||
+		im.Matches("F**T*****") ||
+		im.Matches("F***T****")
+}
+
+// Contains returns true if no points of b lie in the exterior of a,
+// and at least one point of the interior of b lies in the interior of a.
+func Contains(a, b orb.Geometry) bool {
+	return Relate(a, b).Matches("T*****FF*")
+}
+
+// Within returns true if a is completely inside b. It is the same as Contains(b, a).
+func Within(a, b orb.Geometry) bool {
+	return Relate(a, b).Matches("T*F**F***")
+}
+
+// Covers returns true if no points of b lie in the exterior of a.
+// Unlike Contains, it does not distinguish between points on the boundary
+// and in the interior of a.
+func Covers(a, b orb.Geometry) bool {
+	im := Relate(a, b)
+	return im.Matches("T*****FF*") ||
+		im.Matches("*T****FF*") ||
+		im.Matches("***T**FF*") ||
+		im.Matches("****T*FF*")
+}
+
+// CoveredBy returns true if no points of a lie in the exterior of b.
+// It is the same as Covers(b, a).
+func CoveredBy(a, b orb.Geometry) bool {
+	im := Relate(a, b)
+	return im.Matches("T*F**F***") ||
+		im.Matches("*TF**F***") ||
+		im.Matches("**FT*F***") ||
+		im.Matches("**F*TF***")
+}
+
+// Crosses returns true if the geometries have some but not all interior
+// points in common and the dimension of the intersection is less than
+// that of at least one of them. It is only defined for point/line,
+// point/area, line/line and line/area pairs, false is returned for the others.
+func Crosses(a, b orb.Geometry) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	im := Relate(a, b)
+	da, db := a.Dimensions(), b.Dimensions()
+	switch {
+	case da < db:
+		return im.Matches("T*T******")
+	case da > db:
+		return im.Matches("T*****T**")
+	case da == 1:
+		return im.Matches("0********")
+	}
+
+	return false
+}
+
+// Overlaps returns true if the geometries are of the same dimension,
+// share some but not all of their points, and the intersection has the
+// same dimension as the geometries.
+func Overlaps(a, b orb.Geometry) bool {
+	if a == nil || b == nil {
+		return false
+	}
+
+	im := Relate(a, b)
+	da, db := a.Dimensions(), b.Dimensions()
+	switch {
+	case da != db:
+		return false
+	case da == 1:
+		return im.Matches("1*T***T**")
+	}
+
+	return im.Matches("T*T***T**")
+}
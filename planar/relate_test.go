@@ -0,0 +1,248 @@
+package planar
+
+import (
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestRelate(t *testing.T) {
+	square := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}}
+	withHole := orb.Polygon{
+		{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}},
+		{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}},
+	}
+
+	cases := []struct {
+		name   string
+		a, b   orb.Geometry
+		result string
+	}{
+		{
+			name:   "equal points",
+			a:      orb.Point{1, 1},
+			b:      orb.Point{1, 1},
+			result: "0FFFFFFF2",
+		},
+		{
+			name:   "different points",
+			a:      orb.Point{1, 1},
+			b:      orb.Point{1, 2},
+			result: "FF0FFF0F2",
+		},
+		{
+			name:   "point on line",
+			a:      orb.Point{1, 1},
+			b:      orb.LineString{{0, 0}, {2, 2}},
+			result: "0FFFFF102",
+		},
+		{
+			name:   "point at end of line",
+			a:      orb.Point{2, 2},
+			b:      orb.LineString{{0, 0}, {2, 2}},
+			result: "F0FFFF102",
+		},
+		{
+			name:   "crossing lines",
+			a:      orb.LineString{{0, 0}, {2, 2}},
+			b:      orb.LineString{{0, 2}, {2, 0}},
+			result: "0F1FF0102",
+		},
+		{
+			name:   "lines touching at end",
+			a:      orb.LineString{{0, 0}, {1, 1}},
+			b:      orb.LineString{{1, 1}, {2, 0}},
+			result: "FF1F00102",
+		},
+		{
+			name:   "overlapping lines",
+			a:      orb.LineString{{0, 0}, {2, 0}},
+			b:      orb.LineString{{1, 0}, {3, 0}},
+			result: "1010F0102",
+		},
+		{
+			name:   "closed line has no boundary",
+			a:      orb.LineString{{0, 0}, {1, 0}, {1, 1}, {0, 0}},
+			b:      orb.Point{0, 0},
+			result: "0F1FFFFF2",
+		},
+		{
+			name:   "polygon contains point",
+			a:      square,
+			b:      orb.Point{1, 1},
+			result: "0F2FF1FF2",
+		},
+		{
+			name:   "point on polygon boundary",
+			a:      square,
+			b:      orb.Point{1, 0},
+			result: "FF20F1FF2",
+		},
+		{
+			name:   "point in hole",
+			a:      withHole,
+			b:      orb.Point{2, 2},
+			result: "FF2FF10F2",
+		},
+		{
+			name:   "line through polygon",
+			a:      orb.LineString{{-1, 1}, {3, 1}},
+			b:      square,
+			result: "101FF0212",
+		},
+		{
+			name:   "line along polygon boundary",
+			a:      orb.LineString{{0, 0}, {2, 0}},
+			b:      square,
+			result: "F1FF0F212",
+		},
+		{
+			name:   "overlapping polygons",
+			a:      square,
+			b:      orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}},
+			result: "212101212",
+		},
+		{
+			name:   "polygons sharing edge",
+			a:      square,
+			b:      orb.Bound{Min: orb.Point{2, 0}, Max: orb.Point{3, 2}},
+			result: "FF2F11212",
+		},
+		{
+			name:   "polygons touching at corner",
+			a:      square,
+			b:      orb.Bound{Min: orb.Point{2, 2}, Max: orb.Point{3, 3}},
+			result: "FF2F01212",
+		},
+		{
+			name:   "polygon within polygon",
+			a:      orb.Bound{Min: orb.Point{0.5, 0.5}, Max: orb.Point{1, 1}},
+			b:      square,
+			result: "2FF1FF212",
+		},
+		{
+			name:   "equal polygons",
+			a:      square,
+			b:      square.ToRing(),
+			result: "2FFF1FFF2",
+		},
+		{
+			name:   "polygon in hole",
+			a:      orb.Bound{Min: orb.Point{1.5, 1.5}, Max: orb.Point{2.5, 2.5}},
+			b:      withHole,
+			result: "FF2FF1212",
+		},
+		{
+			name:   "empty",
+			a:      orb.LineString{},
+			b:      square,
+			result: "FFFFFF212",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			im := Relate(tc.a, tc.b)
+			if v := im.String(); v != tc.result {
+				t.Errorf("incorrect matrix: %v != %v", v, tc.result)
+			}
+
+			im = Relate(tc.b, tc.a)
+			if v := im.Transpose().String(); v != tc.result {
+				t.Errorf("incorrect reverse matrix: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestIntersectionMatrix_Matches(t *testing.T) {
+	im := Relate(
+		orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}},
+		orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}},
+	)
+
+	cases := []struct {
+		pattern string
+		result  bool
+	}{
+		{pattern: "212101212", result: true},
+		{pattern: "*********", result: true},
+		{pattern: "T*T***T**", result: true},
+		{pattern: "2*2***2**", result: true},
+		{pattern: "FF*FF****", result: false},
+		{pattern: "1********", result: false},
+		{pattern: "T*T***T*", result: false},
+		{pattern: "X********", result: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.pattern, func(t *testing.T) {
+			if v := im.Matches(tc.pattern); v != tc.result {
+				t.Errorf("incorrect match: %v != %v", v, tc.result)
+			}
+		})
+	}
+}
+
+func TestPredicates(t *testing.T) {
+	square := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 2}}
+	inner := orb.Bound{Min: orb.Point{0.5, 0.5}, Max: orb.Point{1, 1}}
+	corner := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{1, 1}}
+	overlap := orb.Bound{Min: orb.Point{1, 1}, Max: orb.Point{3, 3}}
+	touch := orb.Bound{Min: orb.Point{2, 0}, Max: orb.Point{3, 2}}
+	far := orb.Bound{Min: orb.Point{5, 5}, Max: orb.Point{6, 6}}
+
+	crossing := orb.LineString{{-1, 1}, {3, 1}}
+	inside := orb.LineString{{0.5, 1}, {1.5, 1}}
+	boundary := orb.LineString{{0, 0}, {2, 0}}
+
+	cases := []struct {
+		name string
+		f    func(a, b orb.Geometry) bool
+		a, b orb.Geometry
+		want bool
+	}{
+		{name: "disjoint far", f: Disjoint, a: square, b: far, want: true},
+		{name: "disjoint touch", f: Disjoint, a: square, b: touch, want: false},
+		{name: "touches edge", f: Touches, a: square, b: touch, want: true},
+		{name: "touches overlap", f: Touches, a: square, b: overlap, want: false},
+		{name: "touches line on boundary", f: Touches, a: boundary, b: square, want: true},
+		{name: "contains inner", f: Contains, a: square, b: inner, want: true},
+		{name: "contains corner", f: Contains, a: square, b: corner, want: true},
+		{name: "contains boundary line", f: Contains, a: square, b: boundary, want: false},
+		{name: "contains reverse", f: Contains, a: inner, b: square, want: false},
+		{name: "within", f: Within, a: inner, b: square, want: true},
+		{name: "within line", f: Within, a: inside, b: square, want: true},
+		{name: "within crossing line", f: Within, a: crossing, b: square, want: false},
+		{name: "covers boundary line", f: Covers, a: square, b: boundary, want: true},
+		{name: "covers point on boundary", f: Covers, a: square, b: orb.Point{2, 1}, want: true},
+		{name: "covers outside", f: Covers, a: square, b: far, want: false},
+		{name: "covered by", f: CoveredBy, a: boundary, b: square, want: true},
+		{name: "covered by overlap", f: CoveredBy, a: overlap, b: square, want: false},
+		{name: "crosses line polygon", f: Crosses, a: crossing, b: square, want: true},
+		{name: "crosses polygon line", f: Crosses, a: square, b: crossing, want: true},
+		{name: "crosses inside line", f: Crosses, a: inside, b: square, want: false},
+		{name: "crosses lines", f: Crosses, a: orb.LineString{{0, 0}, {2, 2}}, b: orb.LineString{{0, 2}, {2, 0}}, want: true},
+		{name: "crosses polygons", f: Crosses, a: square, b: overlap, want: false},
+		{name: "overlaps polygons", f: Overlaps, a: square, b: overlap, want: true},
+		{name: "overlaps within", f: Overlaps, a: square, b: inner, want: false},
+		{name: "overlaps lines", f: Overlaps, a: orb.LineString{{0, 0}, {2, 0}}, b: orb.LineString{{1, 0}, {3, 0}}, want: true},
+		{name: "overlaps different dims", f: Overlaps, a: square, b: crossing, want: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if v := tc.f(tc.a, tc.b); v != tc.want {
+				t.Errorf("incorrect result: %v != %v", v, tc.want)
+			}
+		})
+	}
+}
+
+func TestRelate_allGeometries(t *testing.T) {
+	for _, a := range orb.AllGeometries {
+		for _, b := range orb.AllGeometries {
+			Relate(a, b)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package planar
+
+import (
+	"math"
+
+	"github.com/paulmach/orb"
+)
+
+// HausdorffDistance returns how far apart two geometries are at their most
+// different, the max distance from a vertex of one geometry to the closest
+// point of the other. Distances are measured to the lines and boundaries of
+// the other geometry, not its area. It is useful to measure how far a
+// simplified line deviates from the original. If either geometry is empty
+// the distance is +Inf.
+func HausdorffDistance(a, b orb.Geometry) float64 {
+	pa := appendPoints(nil, a)
+	pb := appendPoints(nil, b)
+	if len(pa) == 0 || len(pb) == 0 {
+		return math.Inf(1)
+	}
+
+	return math.Max(directedHausdorff(pa, b), directedHausdorff(pb, a))
+}
+
+// directedHausdorff returns the max distance from the points to the geometry.
+func directedHausdorff(points []orb.Point, g orb.Geometry) float64 {
+	max := 0.0
+	for _, p := range points {
+		if d := DistanceFrom(g, p); d > max {
+			max = d
+		}
+	}
+
+	return max
+}
+
+// DiscreteFrechetDistance returns the discrete Fréchet distance between the
+// two lines. It is like the Hausdorff distance but takes the direction and
+// order of the points into account, the shortest leash needed to walk both
+// lines from start to end without going backwards. Only the vertices are
+// considered so lines should have similar point densities. If either line
+// is empty the distance is +Inf.
+func DiscreteFrechetDistance(a, b orb.LineString) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return math.Inf(1)
+	}
+
+	// the coupling distances for the previous and current point of a
+	prev := make([]float64, len(b))
+	curr := make([]float64, len(b))
+
+	for i := range a {
+		for j := range b {
+			d := DistanceSquared(a[i], b[j])
+			switch {
+			case i == 0 && j == 0:
+				curr[j] = d
+			case i == 0:
+				curr[j] = math.Max(curr[j-1], d)
+			case j == 0:
+				curr[j] = math.Max(prev[j], d)
+			default:
+				curr[j] = math.Max(math.Min(prev[j], math.Min(prev[j-1], curr[j-1])), d)
+			}
+		}
+
+		prev, curr = curr, prev
+	}
+
+	return math.Sqrt(prev[len(b)-1])
+}
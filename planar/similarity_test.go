@@ -0,0 +1,136 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestHausdorffDistance(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     orb.Geometry
+		distance float64
+	}{
+		{
+			name:     "same line",
+			a:        orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:        orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			distance: 0,
+		},
+		{
+			name:     "simplified line",
+			a:        orb.LineString{{0, 0}, {1, 0.5}, {2, 0}, {3, 2}, {4, 0}},
+			b:        orb.LineString{{0, 0}, {2, 0}, {3, 2}, {4, 0}},
+			distance: 0.5,
+		},
+		{
+			name:     "extra point far away",
+			a:        orb.MultiPoint{{0, 0}, {1, 0}},
+			b:        orb.MultiPoint{{0, 0}, {1, 0}, {1, 10}},
+			distance: 10,
+		},
+		{
+			name:     "line and point",
+			a:        orb.LineString{{0, 0}, {4, 0}},
+			b:        orb.Point{2, 1},
+			distance: math.Sqrt(5),
+		},
+		{
+			name:     "polygon boundary",
+			a:        orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}},
+			b:        orb.Point{2, 2},
+			distance: math.Sqrt(8),
+		},
+		{
+			name:     "empty",
+			a:        orb.LineString{},
+			b:        orb.Point{2, 2},
+			distance: math.Inf(1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := HausdorffDistance(tc.a, tc.b); d != tc.distance {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if d := HausdorffDistance(tc.b, tc.a); d != tc.distance {
+				t.Errorf("reverse: incorrect distance: %v != %v", d, tc.distance)
+			}
+		})
+	}
+}
+
+func TestDiscreteFrechetDistance(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b     orb.LineString
+		distance float64
+	}{
+		{
+			name:     "same line",
+			a:        orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			b:        orb.LineString{{0, 0}, {1, 1}, {2, 0}},
+			distance: 0,
+		},
+		{
+			name:     "parallel",
+			a:        orb.LineString{{0, 0}, {1, 0}, {2, 0}},
+			b:        orb.LineString{{0, 1}, {1, 1}, {2, 1}},
+			distance: 1,
+		},
+		{
+			name:     "reversed",
+			a:        orb.LineString{{0, 0}, {1, 0}, {2, 0}},
+			b:        orb.LineString{{2, 0}, {1, 0}, {0, 0}},
+			distance: 2,
+		},
+		{
+			name:     "different lengths",
+			a:        orb.LineString{{0, 0}, {1, 0}, {2, 0}, {3, 0}},
+			b:        orb.LineString{{0, 1}, {3, 1}},
+			distance: math.Sqrt2,
+		},
+		{
+			name:     "single points",
+			a:        orb.LineString{{0, 0}},
+			b:        orb.LineString{{3, 4}},
+			distance: 5,
+		},
+		{
+			name:     "empty",
+			a:        orb.LineString{},
+			b:        orb.LineString{{3, 4}},
+			distance: math.Inf(1),
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if d := DiscreteFrechetDistance(tc.a, tc.b); d != tc.distance {
+				t.Errorf("incorrect distance: %v != %v", d, tc.distance)
+			}
+
+			if d := DiscreteFrechetDistance(tc.b, tc.a); d != tc.distance {
+				t.Errorf("reverse: incorrect distance: %v != %v", d, tc.distance)
+			}
+		})
+	}
+}
+
+func TestHausdorffDistance_frechet(t *testing.T) {
+	// the Hausdorff distance ignores direction
+	a := orb.LineString{{0, 0}, {1, 0}, {2, 0}}
+	b := orb.LineString{{2, 0}, {1, 0}, {0, 0}}
+
+	if d := HausdorffDistance(a, b); d != 0 {
+		t.Errorf("incorrect hausdorff distance: %v", d)
+	}
+
+	if d := DiscreteFrechetDistance(a, b); d != 2 {
+		t.Errorf("incorrect frechet distance: %v", d)
+	}
+}
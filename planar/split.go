@@ -0,0 +1,233 @@
+package planar
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// Split cuts the geometry into parts using the blade. Lines are split at
+// the points of the blade that are on the line and where the line crosses
+// or touches the lines of the blade. Polygons are split along the lines
+// of the blade that go all the way across them. The lines of a blade
+// include the boundaries of its polygons. The parts of lines are in order
+// along the line and keep its direction. The parts of polygons have outer
+// rings in counter-clockwise order and holes in clockwise order.
+// Geometries, or parts of multi-geometries, that are not split are returned
+// unchanged. Points can not be split and are also returned unchanged.
+func Split(g, blade orb.Geometry) orb.Collection {
+	if g == nil {
+		return nil
+	}
+
+	var segs []segment
+	if blade != nil {
+		segs = newComponents(blade, 1).segs
+	}
+
+	return appendSplit(nil, g, segs)
+}
+
+func appendSplit(result orb.Collection, g orb.Geometry, blade []segment) orb.Collection {
+	switch g := g.(type) {
+	case nil:
+		return result
+	case orb.Point, orb.MultiPoint:
+		return append(result, g)
+	case orb.LineString:
+		return appendSplitLineString(result, g, blade)
+	case orb.MultiLineString:
+		for _, ls := range g {
+			result = appendSplitLineString(result, ls, blade)
+		}
+		return result
+	case orb.Ring:
+		parts := splitPolygonByBlade(orb.Polygon{g}, blade)
+		if parts == nil {
+			return append(result, g)
+		}
+		return appendPolygons(result, parts)
+	case orb.Polygon:
+		return appendSplitPolygon(result, g, blade)
+	case orb.MultiPolygon:
+		for _, p := range g {
+			result = appendSplitPolygon(result, p, blade)
+		}
+		return result
+	case orb.Collection:
+		for _, c := range g {
+			result = appendSplit(result, c, blade)
+		}
+		return result
+	case orb.Bound:
+		if g.IsEmpty() {
+			return append(result, g)
+		}
+
+		parts := splitPolygonByBlade(g.ToPolygon(), blade)
+		if parts == nil {
+			return append(result, g)
+		}
+		return appendPolygons(result, parts)
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return appendSplit(result, zm.ToXY(g), blade)
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+func appendSplitLineString(result orb.Collection, ls orb.LineString, blade []segment) orb.Collection {
+	parts := splitLineStringByBlade(ls, blade)
+	if parts == nil {
+		return append(result, ls)
+	}
+
+	for _, p := range parts {
+		result = append(result, p)
+	}
+
+	return result
+}
+
+func appendSplitPolygon(result orb.Collection, p orb.Polygon, blade []segment) orb.Collection {
+	parts := splitPolygonByBlade(p, blade)
+	if parts == nil {
+		return append(result, p)
+	}
+
+	return appendPolygons(result, parts)
+}
+
+func appendPolygons(result orb.Collection, mp orb.MultiPolygon) orb.Collection {
+	for _, p := range mp {
+		result = append(result, p)
+	}
+
+	return result
+}
+
+// splitLineStringByBlade returns the parts of the line cut at every point it
+// touches the blade. Returns nil if the line is not split.
+func splitLineStringByBlade(ls orb.LineString, blade []segment) []orb.LineString {
+	if len(ls) < 2 || len(blade) == 0 {
+		return nil
+	}
+
+	segs := make([]segment, 0, len(ls)-1+len(blade))
+	for i := 1; i < len(ls); i++ {
+		segs = append(segs, segment{a: ls[i-1], b: ls[i]})
+	}
+	n := len(segs)
+	segs = append(segs, blade...)
+
+	// the line segments are first so the lower index is on the line.
+	cuts := make([][]orb.Point, n)
+	forEachIntersection(segs, func(i, j int, points []orb.Point) {
+		if segs[i].tag == segs[j].tag {
+			return
+		}
+
+		if j < i {
+			i = j
+		}
+		cuts[i] = append(cuts[i], points...)
+	})
+
+	var result []orb.LineString
+	current := orb.LineString{ls[0]}
+	for i, s := range segs[:n] {
+		points := cuts[i]
+		dx := s.b[0] - s.a[0]
+		dy := s.b[1] - s.a[1]
+		sort.Slice(points, func(i, j int) bool {
+			ti := (points[i][0]-s.a[0])*dx + (points[i][1]-s.a[1])*dy
+			tj := (points[j][0]-s.a[0])*dx + (points[j][1]-s.a[1])*dy
+			return ti < tj
+		})
+
+		for _, p := range points {
+			if p != current[len(current)-1] {
+				current = append(current, p)
+			}
+
+			if len(current) >= 2 {
+				result = append(result, current)
+			}
+			current = orb.LineString{p}
+		}
+
+		if s.b != current[len(current)-1] {
+			current = append(current, s.b)
+		}
+	}
+
+	if len(current) >= 2 {
+		result = append(result, current)
+	}
+
+	if len(result) < 2 {
+		return nil
+	}
+
+	return result
+}
+
+// splitPolygonByBlade returns the faces created by the parts of the blade inside
+// the polygon. Returns nil if the polygon is not split.
+func splitPolygonByBlade(p orb.Polygon, blade []segment) orb.MultiPolygon {
+	if len(p) == 0 || len(p[0]) == 0 {
+		return nil
+	}
+
+	var segs []segment
+	for _, r := range p {
+		segs = appendLineSegments(segs, closeRing(r))
+	}
+
+	n := len(segs)
+	for _, s := range blade {
+		if s.a != s.b {
+			segs = append(segs, s)
+		}
+	}
+
+	if len(segs) == n {
+		return nil
+	}
+
+	// the parts of the blade outside the polygon are not needed, the parts
+	// that do not go all the way across are removed by Polygonize.
+	var lines orb.MultiLineString
+	for _, s := range nodeSegments(segs) {
+		if s.a == s.b {
+			continue
+		}
+
+		if s.tag == 1 {
+			m := orb.Point{(s.a[0] + s.b[0]) / 2, (s.a[1] + s.b[1]) / 2}
+			if !PolygonContains(p, m) {
+				continue
+			}
+		}
+
+		lines = append(lines, orb.LineString{s.a, s.b})
+	}
+
+	var result orb.MultiPolygon
+	for _, f := range Polygonize(lines) {
+		// skip the faces inside the holes of the polygon
+		if PolygonContains(p, PointOnSurface(f)) {
+			result = append(result, f)
+		}
+	}
+
+	if len(result) < 2 {
+		return nil
+	}
+
+	return result
+}
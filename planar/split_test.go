@@ -0,0 +1,304 @@
+package planar
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestSplit_lineString(t *testing.T) {
+	line := orb.LineString{{0, 0}, {5, 0}, {10, 0}}
+
+	cases := []struct {
+		name     string
+		line     orb.LineString
+		blade    orb.Geometry
+		expected orb.Collection
+	}{
+		{
+			name:  "point in segment",
+			line:  line,
+			blade: orb.Point{2, 0},
+			expected: orb.Collection{
+				orb.LineString{{0, 0}, {2, 0}},
+				orb.LineString{{2, 0}, {5, 0}, {10, 0}},
+			},
+		},
+		{
+			name:  "point at vertex",
+			line:  line,
+			blade: orb.Point{5, 0},
+			expected: orb.Collection{
+				orb.LineString{{0, 0}, {5, 0}},
+				orb.LineString{{5, 0}, {10, 0}},
+			},
+		},
+		{
+			name:  "multi point",
+			line:  line,
+			blade: orb.MultiPoint{{8, 0}, {2, 0}, {4, 4}},
+			expected: orb.Collection{
+				orb.LineString{{0, 0}, {2, 0}},
+				orb.LineString{{2, 0}, {5, 0}, {8, 0}},
+				orb.LineString{{8, 0}, {10, 0}},
+			},
+		},
+		{
+			name:     "point at the end",
+			line:     line,
+			blade:    orb.Point{10, 0},
+			expected: orb.Collection{line},
+		},
+		{
+			name:     "point not on line",
+			line:     line,
+			blade:    orb.Point{3, 1},
+			expected: orb.Collection{line},
+		},
+		{
+			name:  "crossing line",
+			line:  line,
+			blade: orb.LineString{{7, -1}, {7, 1}},
+			expected: orb.Collection{
+				orb.LineString{{0, 0}, {5, 0}, {7, 0}},
+				orb.LineString{{7, 0}, {10, 0}},
+			},
+		},
+		{
+			name:  "blade ends on the line",
+			line:  line,
+			blade: orb.LineString{{3, 3}, {3, 0}},
+			expected: orb.Collection{
+				orb.LineString{{0, 0}, {3, 0}},
+				orb.LineString{{3, 0}, {5, 0}, {10, 0}},
+			},
+		},
+		{
+			name:  "overlapping line",
+			line:  line,
+			blade: orb.LineString{{2, 0}, {4, 0}},
+			expected: orb.Collection{
+				orb.LineString{{0, 0}, {2, 0}},
+				orb.LineString{{2, 0}, {4, 0}},
+				orb.LineString{{4, 0}, {5, 0}, {10, 0}},
+			},
+		},
+		{
+			name:  "polygon boundary",
+			line:  line,
+			blade: orb.Bound{Min: orb.Point{2, -1}, Max: orb.Point{4, 1}},
+			expected: orb.Collection{
+				orb.LineString{{0, 0}, {2, 0}},
+				orb.LineString{{2, 0}, {4, 0}},
+				orb.LineString{{4, 0}, {5, 0}, {10, 0}},
+			},
+		},
+		{
+			name:  "self crossing line",
+			line:  orb.LineString{{0, 0}, {4, 0}, {4, 2}, {2, 2}, {2, -2}},
+			blade: orb.Point{3, 0},
+			expected: orb.Collection{
+				orb.LineString{{0, 0}, {3, 0}},
+				orb.LineString{{3, 0}, {4, 0}, {4, 2}, {2, 2}, {2, -2}},
+			},
+		},
+		{
+			name:     "no blade",
+			line:     line,
+			blade:    nil,
+			expected: orb.Collection{line},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := Split(tc.line, tc.blade)
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("incorrect result")
+				t.Logf("%v", result)
+				t.Logf("%v", tc.expected)
+			}
+		})
+	}
+}
+
+func TestSplit_polygon(t *testing.T) {
+	square := orb.Polygon{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}}
+	withHole := orb.Polygon{
+		{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}},
+		{{4, 4}, {4, 6}, {6, 6}, {6, 4}, {4, 4}},
+	}
+
+	cases := []struct {
+		name    string
+		polygon orb.Polygon
+		blade   orb.Geometry
+		areas   []float64
+		holes   int
+	}{
+		{
+			name:    "across",
+			polygon: square,
+			blade:   orb.LineString{{5, -1}, {5, 11}},
+			areas:   []float64{50, 50},
+		},
+		{
+			name:    "corner to corner",
+			polygon: square,
+			blade:   orb.LineString{{0, 0}, {10, 10}},
+			areas:   []float64{50, 50},
+		},
+		{
+			name:    "bent line",
+			polygon: square,
+			blade:   orb.LineString{{-1, 2}, {5, 2}, {5, 11}},
+			areas:   []float64{40, 60},
+		},
+		{
+			name:    "two lines",
+			polygon: square,
+			blade: orb.MultiLineString{
+				{{2, -1}, {2, 11}},
+				{{-1, 5}, {11, 5}},
+			},
+			areas: []float64{10, 10, 40, 40},
+		},
+		{
+			name:    "through the hole",
+			polygon: withHole,
+			blade:   orb.LineString{{5, -1}, {5, 11}},
+			areas:   []float64{48, 48},
+		},
+		{
+			name:    "next to the hole",
+			polygon: withHole,
+			blade:   orb.LineString{{2, -1}, {2, 11}},
+			areas:   []float64{20, 76},
+			holes:   1,
+		},
+		{
+			name:    "into the hole",
+			polygon: withHole,
+			blade:   orb.LineString{{5, -1}, {5, 5}},
+			areas:   []float64{96},
+			holes:   1,
+		},
+		{
+			name:    "not all the way across",
+			polygon: square,
+			blade:   orb.LineString{{5, -1}, {5, 5}},
+			areas:   []float64{100},
+		},
+		{
+			name:    "outside",
+			polygon: square,
+			blade:   orb.LineString{{11, -1}, {11, 11}},
+			areas:   []float64{100},
+		},
+		{
+			name:    "point",
+			polygon: square,
+			blade:   orb.Point{5, 0},
+			areas:   []float64{100},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := Split(tc.polygon, tc.blade)
+			if len(result) != len(tc.areas) {
+				t.Fatalf("incorrect number of parts: %d != %d: %v", len(result), len(tc.areas), result)
+			}
+
+			if len(result) == 1 && !reflect.DeepEqual(result[0], tc.polygon) {
+				t.Errorf("should return the polygon unchanged: %v", result[0])
+			}
+
+			var areas []float64
+			holes := 0
+			for _, g := range result {
+				p := g.(orb.Polygon)
+				if p[0].Orientation() != orb.CCW {
+					t.Errorf("outer ring should be ccw: %v", p)
+				}
+
+				areas = append(areas, Area(p))
+				holes += len(p) - 1
+			}
+
+			for _, a := range tc.areas {
+				found := false
+				for i, v := range areas {
+					if v == a {
+						areas = append(areas[:i], areas[i+1:]...)
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					t.Errorf("missing part with area %v: %v", a, areas)
+				}
+			}
+
+			if holes != tc.holes {
+				t.Errorf("incorrect number of holes: %d != %d", holes, tc.holes)
+			}
+		})
+	}
+}
+
+func TestSplit(t *testing.T) {
+	blade := orb.LineString{{5, -1}, {5, 11}}
+
+	t.Run("nil", func(t *testing.T) {
+		if v := Split(nil, blade); v != nil {
+			t.Errorf("should be nil: %v", v)
+		}
+	})
+
+	t.Run("point", func(t *testing.T) {
+		result := Split(orb.Point{5, 5}, blade)
+		if !reflect.DeepEqual(result, orb.Collection{orb.Point{5, 5}}) {
+			t.Errorf("incorrect result: %v", result)
+		}
+	})
+
+	t.Run("multi polygon", func(t *testing.T) {
+		mp := orb.MultiPolygon{
+			{{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}},
+			{{{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 0}}},
+		}
+
+		result := Split(mp, blade)
+		if len(result) != 3 {
+			t.Fatalf("should have 3 parts: %v", result)
+		}
+
+		if !reflect.DeepEqual(result[2], mp[1]) {
+			t.Errorf("should not change second polygon: %v", result[2])
+		}
+	})
+
+	t.Run("collection", func(t *testing.T) {
+		c := orb.Collection{
+			orb.LineString{{0, 0}, {10, 0}},
+			orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{10, 10}},
+			orb.Ring{{20, 0}, {30, 0}, {30, 10}, {20, 10}, {20, 0}},
+		}
+
+		result := Split(c, blade)
+		if len(result) != 5 {
+			t.Fatalf("should have 5 parts: %v", result)
+		}
+
+		if result[0].GeoJSONType() != "LineString" || result[2].GeoJSONType() != "Polygon" {
+			t.Errorf("incorrect types: %v", result)
+		}
+
+		if _, ok := result[4].(orb.Ring); !ok {
+			t.Errorf("should not change the ring: %v", result[4])
+		}
+	})
+}
@@ -0,0 +1,25 @@
+package planar
+
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/earcut"
+)
+
+// Triangulate splits the polygon into triangles using ear clipping and
+// returns them as indexes into the points of the polygon, three for each
+// triangle. The points are indexed as if the rings were appended together,
+// including the closing points, i.e. the order of
+//
+//	for _, r := range p {
+//		for _, point := range r {
+//			...
+//		}
+//	}
+//
+// so they can be used directly as the vertex and index buffers for WebGL or OpenGL.
+// The triangles are counter-clockwise for any orientation of the rings. Invalid polygons,
+// e.g. with self-intersections, will still be triangulated but the result
+// may not cover the area exactly. This is a port of https://github.com/mapbox/earcut
+func Triangulate(p orb.Polygon) []int {
+	return earcut.Triangulate(p)
+}
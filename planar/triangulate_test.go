@@ -0,0 +1,93 @@
+package planar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestTriangulate(t *testing.T) {
+	square := orb.Ring{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 0}}
+	hole := orb.Ring{{1, 1}, {1, 3}, {3, 3}, {3, 1}, {1, 1}}
+
+	reverse := func(r orb.Ring) orb.Ring {
+		r = r.Clone()
+		r.Reverse()
+		return r
+	}
+
+	cases := []struct {
+		name      string
+		polygon   orb.Polygon
+		triangles int
+	}{
+		{
+			name:      "square",
+			polygon:   orb.Polygon{square},
+			triangles: 2,
+		},
+		{
+			name:      "clockwise square",
+			polygon:   orb.Polygon{reverse(square)},
+			triangles: 2,
+		},
+		{
+			name:      "unclosed ring",
+			polygon:   orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {0, 4}}},
+			triangles: 2,
+		},
+		{
+			name:      "concave",
+			polygon:   orb.Polygon{{{0, 0}, {4, 0}, {4, 4}, {2, 1}, {0, 4}, {0, 0}}},
+			triangles: 3,
+		},
+		{
+			name:      "square with hole",
+			polygon:   orb.Polygon{square, hole},
+			triangles: 8,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tris := Triangulate(tc.polygon)
+			if len(tris) != 3*tc.triangles {
+				t.Errorf("incorrect number of triangles: %d != %d", len(tris)/3, tc.triangles)
+			}
+
+			checkTriangles(t, tc.polygon, tris)
+		})
+	}
+}
+
+// checkTriangles verifies the triangles are counter-clockwise and cover
+// the area of the polygon.
+func checkTriangles(t testing.TB, p orb.Polygon, tris []int) {
+	t.Helper()
+
+	var points []orb.Point
+	for _, r := range p {
+		points = append(points, r...)
+	}
+
+	area := 0.0
+	for i := 0; i < len(tris); i += 3 {
+		for _, j := range tris[i : i+3] {
+			if j < 0 || j >= len(points) {
+				t.Fatalf("index out of range: %d", j)
+			}
+		}
+
+		a, b, c := points[tris[i]], points[tris[i+1]], points[tris[i+2]]
+		ta := ((b[0]-a[0])*(c[1]-a[1]) - (c[0]-a[0])*(b[1]-a[1])) / 2
+		if ta < 0 {
+			t.Errorf("triangle should be counter-clockwise: %v %v %v", a, b, c)
+		}
+		area += ta
+	}
+
+	if expected := Area(p); math.Abs(area-expected) > 1e-9*expected {
+		t.Errorf("incorrect area: %v != %v", area, expected)
+	}
+}
@@ -0,0 +1,333 @@
+package orb
+
+// A PointZ is a point with a z value, e.g. the elevation, stored as [x, y, z].
+type PointZ [3]float64
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (p PointZ) GeoJSONType() string {
+	return "Point"
+}
+
+// Dimensions returns 0 because a point is a 0d object.
+func (p PointZ) Dimensions() int {
+	return 0
+}
+
+// Bound returns a single point bound of the x and y values of the point.
+func (p PointZ) Bound() Bound {
+	return Bound{p.XY(), p.XY()}
+}
+
+// XY returns the 2d point without the extra values.
+func (p PointZ) XY() Point {
+	return Point{p[0], p[1]}
+}
+
+// X returns the horizontal coordinate of the point.
+func (p PointZ) X() float64 {
+	return p[0]
+}
+
+// Y returns the vertical coordinate of the point.
+func (p PointZ) Y() float64 {
+	return p[1]
+}
+
+// Z returns the z coordinate of the point.
+func (p PointZ) Z() float64 {
+	return p[2]
+}
+
+// Equal checks if the point represents the same point.
+func (p PointZ) Equal(point PointZ) bool {
+	return p == point
+}
+
+// A MultiPointZ is a set of points with a z value, e.g. the elevation.
+type MultiPointZ []PointZ
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (mp MultiPointZ) GeoJSONType() string {
+	return "MultiPoint"
+}
+
+// Dimensions returns 0 because a MultiPoint is a 0d object.
+func (mp MultiPointZ) Dimensions() int {
+	return 0
+}
+
+// Bound returns a bound around the x and y values of the points.
+func (mp MultiPointZ) Bound() Bound {
+	if len(mp) == 0 {
+		return emptyBound
+	}
+
+	b := mp[0].Bound()
+	for _, p := range mp {
+		b = b.Extend(p.XY())
+	}
+
+	return b
+}
+
+// XY returns the 2d points without the extra values.
+func (mp MultiPointZ) XY() MultiPoint {
+	if mp == nil {
+		return nil
+	}
+
+	result := make(MultiPoint, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.XY())
+	}
+
+	return result
+}
+
+// Clone returns a new copy of the points.
+func (mp MultiPointZ) Clone() MultiPointZ {
+	if mp == nil {
+		return nil
+	}
+
+	return append(MultiPointZ(nil), mp...)
+}
+
+// Equal compares two MultiPointZ objects. Returns true if lengths are the same
+// and all points are Equal, and in the same order.
+func (mp MultiPointZ) Equal(multiPoint MultiPointZ) bool {
+	if len(mp) != len(multiPoint) {
+		return false
+	}
+
+	for i := range mp {
+		if !mp[i].Equal(multiPoint[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// A PointM is a point with a measure value, e.g. a timestamp or distance, stored as [x, y, m].
+type PointM [3]float64
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (p PointM) GeoJSONType() string {
+	return "Point"
+}
+
+// Dimensions returns 0 because a point is a 0d object.
+func (p PointM) Dimensions() int {
+	return 0
+}
+
+// Bound returns a single point bound of the x and y values of the point.
+func (p PointM) Bound() Bound {
+	return Bound{p.XY(), p.XY()}
+}
+
+// XY returns the 2d point without the extra values.
+func (p PointM) XY() Point {
+	return Point{p[0], p[1]}
+}
+
+// X returns the horizontal coordinate of the point.
+func (p PointM) X() float64 {
+	return p[0]
+}
+
+// Y returns the vertical coordinate of the point.
+func (p PointM) Y() float64 {
+	return p[1]
+}
+
+// M returns the measure value of the point.
+func (p PointM) M() float64 {
+	return p[2]
+}
+
+// Equal checks if the point represents the same point.
+func (p PointM) Equal(point PointM) bool {
+	return p == point
+}
+
+// A MultiPointM is a set of points with a measure value, e.g. a timestamp or distance.
+type MultiPointM []PointM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (mp MultiPointM) GeoJSONType() string {
+	return "MultiPoint"
+}
+
+// Dimensions returns 0 because a MultiPoint is a 0d object.
+func (mp MultiPointM) Dimensions() int {
+	return 0
+}
+
+// Bound returns a bound around the x and y values of the points.
+func (mp MultiPointM) Bound() Bound {
+	if len(mp) == 0 {
+		return emptyBound
+	}
+
+	b := mp[0].Bound()
+	for _, p := range mp {
+		b = b.Extend(p.XY())
+	}
+
+	return b
+}
+
+// XY returns the 2d points without the extra values.
+func (mp MultiPointM) XY() MultiPoint {
+	if mp == nil {
+		return nil
+	}
+
+	result := make(MultiPoint, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.XY())
+	}
+
+	return result
+}
+
+// Clone returns a new copy of the points.
+func (mp MultiPointM) Clone() MultiPointM {
+	if mp == nil {
+		return nil
+	}
+
+	return append(MultiPointM(nil), mp...)
+}
+
+// Equal compares two MultiPointM objects. Returns true if lengths are the same
+// and all points are Equal, and in the same order.
+func (mp MultiPointM) Equal(multiPoint MultiPointM) bool {
+	if len(mp) != len(multiPoint) {
+		return false
+	}
+
+	for i := range mp {
+		if !mp[i].Equal(multiPoint[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// A PointZM is a point with both a z and a measure value, stored as [x, y, z, m].
+type PointZM [4]float64
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (p PointZM) GeoJSONType() string {
+	return "Point"
+}
+
+// Dimensions returns 0 because a point is a 0d object.
+func (p PointZM) Dimensions() int {
+	return 0
+}
+
+// Bound returns a single point bound of the x and y values of the point.
+func (p PointZM) Bound() Bound {
+	return Bound{p.XY(), p.XY()}
+}
+
+// XY returns the 2d point without the extra values.
+func (p PointZM) XY() Point {
+	return Point{p[0], p[1]}
+}
+
+// X returns the horizontal coordinate of the point.
+func (p PointZM) X() float64 {
+	return p[0]
+}
+
+// Y returns the vertical coordinate of the point.
+func (p PointZM) Y() float64 {
+	return p[1]
+}
+
+// Z returns the z coordinate of the point.
+func (p PointZM) Z() float64 {
+	return p[2]
+}
+
+// M returns the measure value of the point.
+func (p PointZM) M() float64 {
+	return p[3]
+}
+
+// Equal checks if the point represents the same point.
+func (p PointZM) Equal(point PointZM) bool {
+	return p == point
+}
+
+// A MultiPointZM is a set of points with both a z and a measure value.
+type MultiPointZM []PointZM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (mp MultiPointZM) GeoJSONType() string {
+	return "MultiPoint"
+}
+
+// Dimensions returns 0 because a MultiPoint is a 0d object.
+func (mp MultiPointZM) Dimensions() int {
+	return 0
+}
+
+// Bound returns a bound around the x and y values of the points.
+func (mp MultiPointZM) Bound() Bound {
+	if len(mp) == 0 {
+		return emptyBound
+	}
+
+	b := mp[0].Bound()
+	for _, p := range mp {
+		b = b.Extend(p.XY())
+	}
+
+	return b
+}
+
+// XY returns the 2d points without the extra values.
+func (mp MultiPointZM) XY() MultiPoint {
+	if mp == nil {
+		return nil
+	}
+
+	result := make(MultiPoint, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.XY())
+	}
+
+	return result
+}
+
+// Clone returns a new copy of the points.
+func (mp MultiPointZM) Clone() MultiPointZM {
+	if mp == nil {
+		return nil
+	}
+
+	return append(MultiPointZM(nil), mp...)
+}
+
+// Equal compares two MultiPointZM objects. Returns true if lengths are the same
+// and all points are Equal, and in the same order.
+func (mp MultiPointZM) Equal(multiPoint MultiPointZM) bool {
+	if len(mp) != len(multiPoint) {
+		return false
+	}
+
+	for i := range mp {
+		if !mp[i].Equal(multiPoint[i]) {
+			return false
+		}
+	}
+
+	return true
+}
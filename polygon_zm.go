@@ -0,0 +1,358 @@
+package orb
+
+// PolygonZ is a closed area with a z value, e.g. the elevation for every point.
+// The first LineStringZ is the outer ring, the others are the holes.
+// Each ring is expected to be closed, ie. the first point matches the last.
+type PolygonZ []LineStringZ
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (p PolygonZ) GeoJSONType() string {
+	return "Polygon"
+}
+
+// Dimensions returns 2 because a Polygon is a 2d object.
+func (p PolygonZ) Dimensions() int {
+	return 2
+}
+
+// Bound returns a bound around the x and y values of the polygon.
+func (p PolygonZ) Bound() Bound {
+	if len(p) == 0 {
+		return emptyBound
+	}
+	return p[0].Bound()
+}
+
+// XY returns the 2d polygon without the extra values.
+func (p PolygonZ) XY() Polygon {
+	if p == nil {
+		return nil
+	}
+
+	result := make(Polygon, 0, len(p))
+	for _, r := range p {
+		result = append(result, Ring(r.XY()))
+	}
+
+	return result
+}
+
+// Clone returns a new deep copy of the polygon.
+func (p PolygonZ) Clone() PolygonZ {
+	return PolygonZ(MultiLineStringZ(p).Clone())
+}
+
+// Equal compares two polygons. Returns true if lengths are the same
+// and all points are Equal.
+func (p PolygonZ) Equal(polygon PolygonZ) bool {
+	return MultiLineStringZ(p).Equal(MultiLineStringZ(polygon))
+}
+
+// MultiPolygonZ is a set of polygons with a z value, e.g. the elevation for every point.
+type MultiPolygonZ []PolygonZ
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (mp MultiPolygonZ) GeoJSONType() string {
+	return "MultiPolygon"
+}
+
+// Dimensions returns 2 because a MultiPolygon is a 2d object.
+func (mp MultiPolygonZ) Dimensions() int {
+	return 2
+}
+
+// Bound returns a bound around the x and y values of the multi-polygon.
+func (mp MultiPolygonZ) Bound() Bound {
+	if len(mp) == 0 {
+		return emptyBound
+	}
+
+	bound := mp[0].Bound()
+	for i := 1; i < len(mp); i++ {
+		bound = bound.Union(mp[i].Bound())
+	}
+
+	return bound
+}
+
+// XY returns the 2d multi-polygon without the extra values.
+func (mp MultiPolygonZ) XY() MultiPolygon {
+	if mp == nil {
+		return nil
+	}
+
+	result := make(MultiPolygon, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.XY())
+	}
+
+	return result
+}
+
+// Clone returns a new deep copy of the multi-polygon.
+func (mp MultiPolygonZ) Clone() MultiPolygonZ {
+	if mp == nil {
+		return nil
+	}
+
+	result := make(MultiPolygonZ, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.Clone())
+	}
+
+	return result
+}
+
+// Equal compares two multi-polygons. Returns true if lengths are the same
+// and all points are Equal.
+func (mp MultiPolygonZ) Equal(multiPolygon MultiPolygonZ) bool {
+	if len(mp) != len(multiPolygon) {
+		return false
+	}
+
+	for i := range mp {
+		if !mp[i].Equal(multiPolygon[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PolygonM is a closed area with a measure value, e.g. a timestamp or distance for every point.
+// The first LineStringM is the outer ring, the others are the holes.
+// Each ring is expected to be closed, ie. the first point matches the last.
+type PolygonM []LineStringM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (p PolygonM) GeoJSONType() string {
+	return "Polygon"
+}
+
+// Dimensions returns 2 because a Polygon is a 2d object.
+func (p PolygonM) Dimensions() int {
+	return 2
+}
+
+// Bound returns a bound around the x and y values of the polygon.
+func (p PolygonM) Bound() Bound {
+	if len(p) == 0 {
+		return emptyBound
+	}
+	return p[0].Bound()
+}
+
+// XY returns the 2d polygon without the extra values.
+func (p PolygonM) XY() Polygon {
+	if p == nil {
+		return nil
+	}
+
+	result := make(Polygon, 0, len(p))
+	for _, r := range p {
+		result = append(result, Ring(r.XY()))
+	}
+
+	return result
+}
+
+// Clone returns a new deep copy of the polygon.
+func (p PolygonM) Clone() PolygonM {
+	return PolygonM(MultiLineStringM(p).Clone())
+}
+
+// Equal compares two polygons. Returns true if lengths are the same
+// and all points are Equal.
+func (p PolygonM) Equal(polygon PolygonM) bool {
+	return MultiLineStringM(p).Equal(MultiLineStringM(polygon))
+}
+
+// MultiPolygonM is a set of polygons with a measure value, e.g. a timestamp or distance for every point.
+type MultiPolygonM []PolygonM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (mp MultiPolygonM) GeoJSONType() string {
+	return "MultiPolygon"
+}
+
+// Dimensions returns 2 because a MultiPolygon is a 2d object.
+func (mp MultiPolygonM) Dimensions() int {
+	return 2
+}
+
+// Bound returns a bound around the x and y values of the multi-polygon.
+func (mp MultiPolygonM) Bound() Bound {
+	if len(mp) == 0 {
+		return emptyBound
+	}
+
+	bound := mp[0].Bound()
+	for i := 1; i < len(mp); i++ {
+		bound = bound.Union(mp[i].Bound())
+	}
+
+	return bound
+}
+
+// XY returns the 2d multi-polygon without the extra values.
+func (mp MultiPolygonM) XY() MultiPolygon {
+	if mp == nil {
+		return nil
+	}
+
+	result := make(MultiPolygon, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.XY())
+	}
+
+	return result
+}
+
+// Clone returns a new deep copy of the multi-polygon.
+func (mp MultiPolygonM) Clone() MultiPolygonM {
+	if mp == nil {
+		return nil
+	}
+
+	result := make(MultiPolygonM, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.Clone())
+	}
+
+	return result
+}
+
+// Equal compares two multi-polygons. Returns true if lengths are the same
+// and all points are Equal.
+func (mp MultiPolygonM) Equal(multiPolygon MultiPolygonM) bool {
+	if len(mp) != len(multiPolygon) {
+		return false
+	}
+
+	for i := range mp {
+		if !mp[i].Equal(multiPolygon[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PolygonZM is a closed area with both a z and a measure value for every point.
+// The first LineStringZM is the outer ring, the others are the holes.
+// Each ring is expected to be closed, ie. the first point matches the last.
+type PolygonZM []LineStringZM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (p PolygonZM) GeoJSONType() string {
+	return "Polygon"
+}
+
+// Dimensions returns 2 because a Polygon is a 2d object.
+func (p PolygonZM) Dimensions() int {
+	return 2
+}
+
+// Bound returns a bound around the x and y values of the polygon.
+func (p PolygonZM) Bound() Bound {
+	if len(p) == 0 {
+		return emptyBound
+	}
+	return p[0].Bound()
+}
+
+// XY returns the 2d polygon without the extra values.
+func (p PolygonZM) XY() Polygon {
+	if p == nil {
+		return nil
+	}
+
+	result := make(Polygon, 0, len(p))
+	for _, r := range p {
+		result = append(result, Ring(r.XY()))
+	}
+
+	return result
+}
+
+// Clone returns a new deep copy of the polygon.
+func (p PolygonZM) Clone() PolygonZM {
+	return PolygonZM(MultiLineStringZM(p).Clone())
+}
+
+// Equal compares two polygons. Returns true if lengths are the same
+// and all points are Equal.
+func (p PolygonZM) Equal(polygon PolygonZM) bool {
+	return MultiLineStringZM(p).Equal(MultiLineStringZM(polygon))
+}
+
+// MultiPolygonZM is a set of polygons with both a z and a measure value for every point.
+type MultiPolygonZM []PolygonZM
+
+// GeoJSONType returns the GeoJSON type for the object.
+func (mp MultiPolygonZM) GeoJSONType() string {
+	return "MultiPolygon"
+}
+
+// Dimensions returns 2 because a MultiPolygon is a 2d object.
+func (mp MultiPolygonZM) Dimensions() int {
+	return 2
+}
+
+// Bound returns a bound around the x and y values of the multi-polygon.
+func (mp MultiPolygonZM) Bound() Bound {
+	if len(mp) == 0 {
+		return emptyBound
+	}
+
+	bound := mp[0].Bound()
+	for i := 1; i < len(mp); i++ {
+		bound = bound.Union(mp[i].Bound())
+	}
+
+	return bound
+}
+
+// XY returns the 2d multi-polygon without the extra values.
+func (mp MultiPolygonZM) XY() MultiPolygon {
+	if mp == nil {
+		return nil
+	}
+
+	result := make(MultiPolygon, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.XY())
+	}
+
+	return result
+}
+
+// Clone returns a new deep copy of the multi-polygon.
+func (mp MultiPolygonZM) Clone() MultiPolygonZM {
+	if mp == nil {
+		return nil
+	}
+
+	result := make(MultiPolygonZM, 0, len(mp))
+	for _, p := range mp {
+		result = append(result, p.Clone())
+	}
+
+	return result
+}
+
+// Equal compares two multi-polygons. Returns true if lengths are the same
+// and all points are Equal.
+func (mp MultiPolygonZM) Equal(multiPolygon MultiPolygonZM) bool {
+	if len(mp) != len(multiPolygon) {
+		return false
+	}
+
+	for i := range mp {
+		if !mp[i].Equal(multiPolygon[i]) {
+			return false
+		}
+	}
+
+	return true
+}
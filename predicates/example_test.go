@@ -0,0 +1,23 @@
+package predicates_test
+
+import (
+	"fmt"
+
+	"github.com/paulmach/orb/predicates"
+)
+
+func ExampleOrient2D() {
+	// c is a tiny bit above the line through a and b
+	a := [2]float64{0.5, 0.5}
+	b := [2]float64{12, 12}
+	c := [2]float64{24, 24.000000000000004}
+
+	naive := (a[0]-c[0])*(b[1]-c[1]) - (a[1]-c[1])*(b[0]-c[0])
+	fmt.Println(naive)
+
+	robust := predicates.Orient2D(a[0], a[1], b[0], b[1], c[0], c[1])
+	fmt.Println(robust > 0)
+	// Output:
+	// 0
+	// true
+}
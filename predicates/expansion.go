@@ -0,0 +1,133 @@
+package predicates
+
+import "math"
+
+// The exact arithmetic below represents a number as an expansion, a sum of
+// float64 components that do not overlap, sorted by increasing magnitude.
+// See Shewchuk, Adaptive Precision Floating-Point Arithmetic and Fast
+// Robust Geometric Predicates, 1997.
+
+// twoSum returns the sum of a and b and the roundoff error.
+func twoSum(a, b float64) (float64, float64) {
+	x := a + b
+	bv := x - a
+	av := x - bv
+	return x, (a - av) + (b - bv)
+}
+
+// fastTwoSum is the same as twoSum but requires |a| >= |b|.
+func fastTwoSum(a, b float64) (float64, float64) {
+	x := a + b
+	return x, b - (x - a)
+}
+
+// twoDiff returns the difference of a and b and the roundoff error.
+func twoDiff(a, b float64) (float64, float64) {
+	x := a - b
+	bv := a - x
+	av := x + bv
+	return x, (a - av) + (bv - b)
+}
+
+// twoProduct returns the product of a and b and the roundoff error.
+func twoProduct(a, b float64) (float64, float64) {
+	x := a * b
+	return x, math.FMA(a, b, -x)
+}
+
+// diff returns the exact difference of a and b as an expansion.
+func diff(a, b float64) []float64 {
+	x, y := twoDiff(a, b)
+	return []float64{y, x}
+}
+
+// grow returns the expansion plus b, removing zero components.
+func grow(e []float64, b float64) []float64 {
+	result := make([]float64, 0, len(e)+1)
+
+	q := b
+	for _, v := range e {
+		var h float64
+		q, h = twoSum(q, v)
+		if h != 0 {
+			result = append(result, h)
+		}
+	}
+
+	if q != 0 || len(result) == 0 {
+		result = append(result, q)
+	}
+
+	return result
+}
+
+// sum returns the exact sum of the expansions.
+func sum(e, f []float64) []float64 {
+	for _, v := range f {
+		e = grow(e, v)
+	}
+
+	return e
+}
+
+// scale returns the expansion times b, removing zero components.
+func scale(e []float64, b float64) []float64 {
+	result := make([]float64, 0, 2*len(e))
+
+	q, h := twoProduct(e[0], b)
+	if h != 0 {
+		result = append(result, h)
+	}
+
+	for _, v := range e[1:] {
+		p1, p0 := twoProduct(v, b)
+
+		var s float64
+		s, h = twoSum(q, p0)
+		if h != 0 {
+			result = append(result, h)
+		}
+
+		q, h = fastTwoSum(p1, s)
+		if h != 0 {
+			result = append(result, h)
+		}
+	}
+
+	if q != 0 || len(result) == 0 {
+		result = append(result, q)
+	}
+
+	return result
+}
+
+// mul returns the exact product of the expansions.
+func mul(e, f []float64) []float64 {
+	result := []float64{0}
+	for _, v := range f {
+		result = sum(result, scale(e, v))
+	}
+
+	return result
+}
+
+// negate returns the expansion times -1.
+func negate(e []float64) []float64 {
+	result := make([]float64, len(e))
+	for i, v := range e {
+		result[i] = -v
+	}
+
+	return result
+}
+
+// estimate returns the float64 closest to the value of the expansion.
+// It has the same sign as the largest component.
+func estimate(e []float64) float64 {
+	v := 0.0
+	for _, c := range e {
+		v += c
+	}
+
+	return v
+}
@@ -0,0 +1,174 @@
+// Package predicates implements robust geometric predicates using Shewchuk's
+// adaptive precision approach. The result is first computed with float64
+// arithmetic and only if it is too close to zero to trust, e.g. for nearly
+// collinear points, the exact value is computed. So the sign of the result
+// is always correct and most calls are as fast as the naive version.
+package predicates
+
+// epsilon is half the machine epsilon, the max relative error of
+// a single float64 operation.
+const epsilon = 1.1102230246251565e-16
+
+var (
+	orientErrBound   = (3 + 16*epsilon) * epsilon
+	inCircleErrBound = (10 + 96*epsilon) * epsilon
+)
+
+// Orient2D returns a positive value if the points a, b and c are in
+// counter-clockwise order, c is to the left of the line from a to b,
+// a negative value if clockwise and zero if they are collinear.
+// The value is approximately twice the signed area of the triangle.
+func Orient2D(ax, ay, bx, by, cx, cy float64) float64 {
+	detLeft := (ax - cx) * (by - cy)
+	detRight := (ay - cy) * (bx - cx)
+	det := detLeft - detRight
+
+	var detSum float64
+	switch {
+	case detLeft > 0:
+		if detRight <= 0 {
+			return det
+		}
+		detSum = detLeft + detRight
+	case detLeft < 0:
+		if detRight >= 0 {
+			return det
+		}
+		detSum = -detLeft - detRight
+	default:
+		return det
+	}
+
+	if bound := orientErrBound * detSum; det >= bound || -det >= bound {
+		return det
+	}
+
+	return orient2DExact(ax, ay, bx, by, cx, cy)
+}
+
+// orient2DExact computes the orientation determinant using exact arithmetic,
+// as the sum of the exact products ax*by - ay*bx + bx*cy - by*cx + cx*ay - cy*ax.
+func orient2DExact(ax, ay, bx, by, cx, cy float64) float64 {
+	var e []float64
+	for _, t := range [...][4]float64{
+		{ax, by, ay, bx},
+		{bx, cy, by, cx},
+		{cx, ay, cy, ax},
+	} {
+		x, y := twoProduct(t[0], t[1])
+		e = grow(grow(e, y), x)
+
+		x, y = twoProduct(-t[2], t[3])
+		e = grow(grow(e, y), x)
+	}
+
+	return estimate(e)
+}
+
+// OrientRing returns a positive value if the ring is in counter-clockwise
+// order, a negative value if clockwise and zero if its signed area is zero,
+// e.g. all the points are collinear or it is a bow-tie with equal lobes.
+// The ring is implicitly closed. The value is approximately twice the
+// signed area of the ring.
+func OrientRing(points [][2]float64) float64 {
+	det, magnitude := 0.0, 0.0
+	for i, p := range points {
+		q := points[(i+1)%len(points)]
+
+		left, right := p[0]*q[1], q[0]*p[1]
+		det += left - right
+		magnitude += abs(left) + abs(right)
+	}
+
+	bound := OrientRingErrBound(len(points), magnitude)
+	if det > bound || -det > bound {
+		return det
+	}
+
+	return orientRingExact(points)
+}
+
+// OrientRingErrBound returns the max roundoff error of twice the signed area
+// of a ring with n points computed with float64 arithmetic, the sum of the
+// cross products of the edges. The magnitude is the sum of the absolute values
+// of the products. The products can be of the coordinates relative to a point,
+// e.g. the first one. If the computed value is larger than the bound its sign
+// is correct, otherwise OrientRing should be used.
+func OrientRingErrBound(n int, magnitude float64) float64 {
+	// the roundoff error of the sum grows with the number of terms
+	return float64(2*n+4) * epsilon * magnitude
+}
+
+// orientRingExact computes the sum of the exact products
+// p[0]*q[1] - q[0]*p[1] for all the edges of the ring.
+func orientRingExact(points [][2]float64) float64 {
+	var e []float64
+	for i, p := range points {
+		q := points[(i+1)%len(points)]
+
+		x, y := twoProduct(p[0], q[1])
+		e = grow(grow(e, y), x)
+
+		x, y = twoProduct(-q[0], p[1])
+		e = grow(grow(e, y), x)
+	}
+
+	return estimate(e)
+}
+
+// InCircle returns a positive value if the point d is inside the circle
+// through a, b and c, a negative value if outside and zero if it is on the
+// circle. The points a, b and c must be in counter-clockwise order,
+// otherwise the sign is reversed.
+func InCircle(ax, ay, bx, by, cx, cy, dx, dy float64) float64 {
+	adx, ady := ax-dx, ay-dy
+	bdx, bdy := bx-dx, by-dy
+	cdx, cdy := cx-dx, cy-dy
+
+	bdxcdy, cdxbdy := bdx*cdy, cdx*bdy
+	alift := adx*adx + ady*ady
+
+	cdxady, adxcdy := cdx*ady, adx*cdy
+	blift := bdx*bdx + bdy*bdy
+
+	adxbdy, bdxady := adx*bdy, bdx*ady
+	clift := cdx*cdx + cdy*cdy
+
+	det := alift*(bdxcdy-cdxbdy) + blift*(cdxady-adxcdy) + clift*(adxbdy-bdxady)
+
+	permanent := (abs(bdxcdy)+abs(cdxbdy))*alift +
+		(abs(cdxady)+abs(adxcdy))*blift +
+		(abs(adxbdy)+abs(bdxady))*clift
+
+	if bound := inCircleErrBound * permanent; det > bound || -det > bound {
+		return det
+	}
+
+	return inCircleExact(ax, ay, bx, by, cx, cy, dx, dy)
+}
+
+// inCircleExact computes the in-circle determinant using exact arithmetic.
+func inCircleExact(ax, ay, bx, by, cx, cy, dx, dy float64) float64 {
+	adx, ady := diff(ax, dx), diff(ay, dy)
+	bdx, bdy := diff(bx, dx), diff(by, dy)
+	cdx, cdy := diff(cx, dx), diff(cy, dy)
+
+	alift := sum(mul(adx, adx), mul(ady, ady))
+	blift := sum(mul(bdx, bdx), mul(bdy, bdy))
+	clift := sum(mul(cdx, cdx), mul(cdy, cdy))
+
+	bc := sum(mul(bdx, cdy), negate(mul(cdx, bdy)))
+	ca := sum(mul(cdx, ady), negate(mul(adx, cdy)))
+	ab := sum(mul(adx, bdy), negate(mul(bdx, ady)))
+
+	det := sum(sum(mul(alift, bc), mul(blift, ca)), mul(clift, ab))
+	return estimate(det)
+}
+
+func abs(v float64) float64 {
+	if v < 0 {
+		return -v
+	}
+
+	return v
+}
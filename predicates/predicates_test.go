@@ -0,0 +1,329 @@
+package predicates
+
+import (
+	"math"
+	"math/big"
+	"math/rand"
+	"testing"
+)
+
+func TestOrient2D(t *testing.T) {
+	cases := []struct {
+		name     string
+		a, b, c  [2]float64
+		expected int
+	}{
+		{
+			name:     "left",
+			a:        [2]float64{0, 0},
+			b:        [2]float64{1, 0},
+			c:        [2]float64{0, 1},
+			expected: 1,
+		},
+		{
+			name:     "right",
+			a:        [2]float64{0, 0},
+			b:        [2]float64{1, 0},
+			c:        [2]float64{0, -1},
+			expected: -1,
+		},
+		{
+			name:     "collinear",
+			a:        [2]float64{0, 0},
+			b:        [2]float64{1, 1},
+			c:        [2]float64{3, 3},
+			expected: 0,
+		},
+		{
+			name:     "same points",
+			a:        [2]float64{1, 2},
+			b:        [2]float64{1, 2},
+			c:        [2]float64{1, 2},
+			expected: 0,
+		},
+		{
+			name:     "nearly collinear",
+			a:        [2]float64{0.5, 0.5},
+			b:        [2]float64{12, 12},
+			c:        [2]float64{24, 24.000000000000004},
+			expected: 1,
+		},
+		{
+			name:     "large values",
+			a:        [2]float64{1e15, 1e15},
+			b:        [2]float64{1e15 + 1, 1e15 + 1},
+			c:        [2]float64{1e15 + 2, 1e15 + 2 + 0.25},
+			expected: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := Orient2D(tc.a[0], tc.a[1], tc.b[0], tc.b[1], tc.c[0], tc.c[1])
+			if s := sign(v); s != tc.expected {
+				t.Errorf("incorrect sign: %v != %v (%v)", s, tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestOrient2D_exact(t *testing.T) {
+	// the points near (0.5, 0.5) on a grid of the smallest steps.
+	// The naive formula gives a noisy pattern of signs.
+	ulp := math.Nextafter(0.5, 1) - 0.5
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 64; j++ {
+			ax, ay := 0.5+float64(i)*ulp, 0.5+float64(j)*ulp
+			v := Orient2D(ax, ay, 12, 12, 24, 24)
+
+			expected := exactOrient(ax, ay, 12, 12, 24, 24)
+			if s := sign(v); s != expected {
+				t.Fatalf("incorrect sign for %d, %d: %v != %v", i, j, s, expected)
+			}
+		}
+	}
+}
+
+func TestOrient2D_antisymmetric(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 1000; i++ {
+		ax, ay := r.Float64(), r.Float64()
+		bx, by := r.Float64(), r.Float64()
+
+		// a point nearly on the line
+		f := r.Float64()
+		cx := ax + f*(bx-ax)
+		cy := ay + f*(by-ay)
+
+		v1 := sign(Orient2D(ax, ay, bx, by, cx, cy))
+		v2 := sign(Orient2D(bx, by, ax, ay, cx, cy))
+		v3 := sign(Orient2D(bx, by, cx, cy, ax, ay))
+
+		if v1 != -v2 || v1 != v3 {
+			t.Fatalf("inconsistent signs: %v %v %v", v1, v2, v3)
+		}
+
+		if e := exactOrient(ax, ay, bx, by, cx, cy); v1 != e {
+			t.Fatalf("incorrect sign: %v != %v", v1, e)
+		}
+	}
+}
+
+func TestOrientRing(t *testing.T) {
+	cases := []struct {
+		name     string
+		points   [][2]float64
+		expected int
+	}{
+		{
+			name:     "ccw square",
+			points:   [][2]float64{{0, 0}, {1, 0}, {1, 1}, {0, 1}, {0, 0}},
+			expected: 1,
+		},
+		{
+			name:     "cw square, not closed",
+			points:   [][2]float64{{0, 0}, {0, 1}, {1, 1}, {1, 0}},
+			expected: -1,
+		},
+		{
+			name:     "bow-tie with equal lobes",
+			points:   [][2]float64{{0, 0}, {1, 1}, {1, 0}, {0, 1}, {0, 0}},
+			expected: 0,
+		},
+		{
+			name:     "nearly collinear",
+			points:   [][2]float64{{12, 12}, {24, 24}, {0.5, 0.5000000000000001}, {12, 12}},
+			expected: 1,
+		},
+		{
+			name:     "collinear",
+			points:   [][2]float64{{0.5, 0.5}, {12, 12}, {24, 24}},
+			expected: 0,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := OrientRing(tc.points)
+			if s := sign(v); s != tc.expected {
+				t.Errorf("incorrect sign: %v != %v (%v)", s, tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestOrientRing_triangle(t *testing.T) {
+	// should match the orientation of the triangle
+	ulp := math.Nextafter(0.5, 1) - 0.5
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 64; j++ {
+			ax, ay := 0.5+float64(i)*ulp, 0.5+float64(j)*ulp
+			v := OrientRing([][2]float64{{ax, ay}, {12, 12}, {24, 24}})
+
+			expected := exactOrient(ax, ay, 12, 12, 24, 24)
+			if s := sign(v); s != expected {
+				t.Fatalf("incorrect sign for %d, %d: %v != %v", i, j, s, expected)
+			}
+		}
+	}
+}
+
+func TestOrientRingErrBound(t *testing.T) {
+	// the sign of the float64 value must be correct outside the bound
+	ulp := math.Nextafter(0.5, 1) - 0.5
+	for i := 0; i < 64; i++ {
+		for j := 0; j < 64; j++ {
+			points := [][2]float64{{0.5 + float64(i)*ulp, 0.5 + float64(j)*ulp}, {12, 12}, {24, 24}}
+
+			det, magnitude := 0.0, 0.0
+			for k, p := range points {
+				q := points[(k+1)%len(points)]
+				det += p[0]*q[1] - q[0]*p[1]
+				magnitude += math.Abs(p[0]*q[1]) + math.Abs(q[0]*p[1])
+			}
+
+			if math.Abs(det) <= OrientRingErrBound(len(points), magnitude) {
+				continue
+			}
+
+			expected := exactOrient(points[0][0], points[0][1], 12, 12, 24, 24)
+			if s := sign(det); s != expected {
+				t.Fatalf("incorrect sign for %d, %d: %v != %v", i, j, s, expected)
+			}
+		}
+	}
+}
+
+func TestInCircle(t *testing.T) {
+	cases := []struct {
+		name       string
+		a, b, c, d [2]float64
+		expected   int
+	}{
+		{
+			name:     "inside",
+			a:        [2]float64{1, 0},
+			b:        [2]float64{0, 1},
+			c:        [2]float64{-1, 0},
+			d:        [2]float64{0, 0},
+			expected: 1,
+		},
+		{
+			name:     "outside",
+			a:        [2]float64{1, 0},
+			b:        [2]float64{0, 1},
+			c:        [2]float64{-1, 0},
+			d:        [2]float64{2, 2},
+			expected: -1,
+		},
+		{
+			name:     "on circle",
+			a:        [2]float64{1, 0},
+			b:        [2]float64{0, 1},
+			c:        [2]float64{-1, 0},
+			d:        [2]float64{0, -1},
+			expected: 0,
+		},
+		{
+			name:     "clockwise is reversed",
+			a:        [2]float64{-1, 0},
+			b:        [2]float64{0, 1},
+			c:        [2]float64{1, 0},
+			d:        [2]float64{0, 0},
+			expected: -1,
+		},
+		{
+			name:     "nearly on circle",
+			a:        [2]float64{1, 0},
+			b:        [2]float64{0, 1},
+			c:        [2]float64{-1, 0},
+			d:        [2]float64{0, math.Nextafter(-1, 0)},
+			expected: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			v := InCircle(tc.a[0], tc.a[1], tc.b[0], tc.b[1], tc.c[0], tc.c[1], tc.d[0], tc.d[1])
+			if s := sign(v); s != tc.expected {
+				t.Errorf("incorrect sign: %v != %v (%v)", s, tc.expected, v)
+			}
+		})
+	}
+}
+
+func TestInCircle_exact(t *testing.T) {
+	// points near a circle through large coordinates
+	ulp := math.Nextafter(1e6, 2e6) - 1e6
+	for i := -16; i < 16; i++ {
+		for j := -16; j < 16; j++ {
+			dx, dy := 1e6+float64(i)*ulp, 1e6+1+float64(j)*ulp
+			v := InCircle(1e6+1, 1e6, 1e6, 1e6+1, 1e6-1, 1e6, dx, dy)
+
+			expected := exactInCircle(1e6+1, 1e6, 1e6, 1e6+1, 1e6-1, 1e6, dx, dy)
+			if s := sign(v); s != expected {
+				t.Fatalf("incorrect sign for %d, %d: %v != %v", i, j, s, expected)
+			}
+		}
+	}
+}
+
+func TestInCircle_random(t *testing.T) {
+	r := rand.New(rand.NewSource(42))
+	for i := 0; i < 1000; i++ {
+		var v [8]float64
+		for j := range v {
+			v[j] = r.Float64()
+		}
+
+		s := sign(InCircle(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7]))
+		if e := exactInCircle(v[0], v[1], v[2], v[3], v[4], v[5], v[6], v[7]); s != e {
+			t.Fatalf("incorrect sign: %v != %v", s, e)
+		}
+	}
+}
+
+func sign(v float64) int {
+	if v > 0 {
+		return 1
+	} else if v < 0 {
+		return -1
+	}
+
+	return 0
+}
+
+func exactOrient(ax, ay, bx, by, cx, cy float64) int {
+	r := func(v float64) *big.Rat { return new(big.Rat).SetFloat64(v) }
+
+	acx := new(big.Rat).Sub(r(ax), r(cx))
+	bcy := new(big.Rat).Sub(r(by), r(cy))
+	acy := new(big.Rat).Sub(r(ay), r(cy))
+	bcx := new(big.Rat).Sub(r(bx), r(cx))
+
+	left := new(big.Rat).Mul(acx, bcy)
+	right := new(big.Rat).Mul(acy, bcx)
+	return left.Cmp(right)
+}
+
+func exactInCircle(ax, ay, bx, by, cx, cy, dx, dy float64) int {
+	r := func(v float64) *big.Rat { return new(big.Rat).SetFloat64(v) }
+	sub := func(a, b float64) *big.Rat { return new(big.Rat).Sub(r(a), r(b)) }
+	mul := func(a, b *big.Rat) *big.Rat { return new(big.Rat).Mul(a, b) }
+	add := func(a, b *big.Rat) *big.Rat { return new(big.Rat).Add(a, b) }
+
+	adx, ady := sub(ax, dx), sub(ay, dy)
+	bdx, bdy := sub(bx, dx), sub(by, dy)
+	cdx, cdy := sub(cx, dx), sub(cy, dy)
+
+	alift := add(mul(adx, adx), mul(ady, ady))
+	blift := add(mul(bdx, bdx), mul(bdy, bdy))
+	clift := add(mul(cdx, cdx), mul(cdy, cdy))
+
+	bc := new(big.Rat).Sub(mul(bdx, cdy), mul(cdx, bdy))
+	ca := new(big.Rat).Sub(mul(cdx, ady), mul(adx, cdy))
+	ab := new(big.Rat).Sub(mul(adx, bdy), mul(bdx, ady))
+
+	det := add(add(mul(alift, bc), mul(blift, ca)), mul(clift, ab))
+	return det.Sign()
+}
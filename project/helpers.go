@@ -2,7 +2,10 @@
 // along with helpers to apply them to orb geometry types.
 package project
 
-import "github.com/paulmach/orb"
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
 
 // Geometry is a helper to project any geomtry.
 func Geometry(g orb.Geometry, proj orb.Projection) orb.Geometry {
@@ -31,6 +34,10 @@ func Geometry(g orb.Geometry, proj orb.Projection) orb.Geometry {
 		return Bound(g, proj)
 	}
 
+	if zm.LayoutOf(g) != zm.XY {
+		return Geometry(zm.ToXY(g), proj)
+	}
+
 	panic("geometry type not supported")
 }
 
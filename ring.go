@@ -1,5 +1,11 @@
 package orb
 
+import (
+	"math"
+
+	"github.com/paulmach/orb/predicates"
+)
+
 // Ring represents a set of ring on the earth.
 type Ring LineString
 
@@ -33,24 +39,46 @@ func (r Ring) Bound() Bound {
 
 // Orientation returns 1 if the the ring is in couter-clockwise order,
 // return -1 if the ring is the clockwise order and 0 if the ring is
-// degenerate and had no area.
+// degenerate and had no area. Nearly degenerate rings, where roundoff
+// could flip the sign of the area, use the exact area.
 func (r Ring) Orientation() Orientation {
 	area := 0.0
+	magnitude := 0.0
 
 	// This is a fast planar area computation, which is okay for this use.
 	// implicitly move everything to near the origin to help with roundoff
 	offsetX := r[0][0]
 	offsetY := r[0][1]
 	for i := 1; i < len(r)-1; i++ {
-		area += (r[i][0]-offsetX)*(r[i+1][1]-offsetY) -
-			(r[i+1][0]-offsetX)*(r[i][1]-offsetY)
+		left := (r[i][0] - offsetX) * (r[i+1][1] - offsetY)
+		right := (r[i+1][0] - offsetX) * (r[i][1] - offsetY)
+
+		area += left - right
+		magnitude += math.Abs(left) + math.Abs(right)
+	}
+
+	bound := predicates.OrientRingErrBound(len(r), magnitude)
+	if area > bound {
+		return CCW
+	}
+
+	if area < -bound {
+		return CW
+	}
+
+	// too close to zero to trust, use the exact area so a ring without
+	// area, e.g. a bow-tie with equal lobes, returns 0.
+	points := make([][2]float64, len(r))
+	for i, p := range r {
+		points[i] = p
 	}
 
-	if area > 0 {
+	o := predicates.OrientRing(points)
+	if o > 0 {
 		return CCW
 	}
 
-	if area < 0 {
+	if o < 0 {
 		return CW
 	}
 
@@ -67,6 +67,26 @@ func TestRing_Orientation(t *testing.T) {
 			ring:   Ring{{0, 0}, {0, 0.001}, {0.001, 0.001}, {0.001, 0}, {0, 0}},
 			result: CW,
 		},
+		{
+			name:   "nearly collinear, ccw",
+			ring:   Ring{{12, 12}, {24, 24}, {0.5, 0.5000000000000001}, {12, 12}},
+			result: CCW,
+		},
+		{
+			name:   "nearly collinear, cw",
+			ring:   Ring{{12, 12}, {0.5, 0.5000000000000001}, {24, 24}, {12, 12}},
+			result: CW,
+		},
+		{
+			name:   "collinear",
+			ring:   Ring{{0, 0}, {1, 1}, {2, 2}, {0, 0}},
+			result: 0,
+		},
+		{
+			name:   "bow-tie with equal lobes",
+			ring:   Ring{{0, 0}, {1, 1}, {1, 0}, {0, 1}, {0, 0}},
+			result: 0,
+		},
 	}
 
 	for _, tc := range cases {
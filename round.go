@@ -6,7 +6,9 @@ import (
 )
 
 // Round will round all the coordinates of the geometry to the given factor.
-// The default is 6 decimal places.
+// The default is 6 decimal places. Each coordinate is rounded on its own
+// which can collapse rings or create self-intersections, use
+// planar.PrecisionModel to reduce precision while keeping the geometry valid.
 func Round(g Geometry, factor ...int) Geometry {
 	if g == nil {
 		return nil
@@ -89,6 +91,10 @@ func Round(g Geometry, factor ...int) Geometry {
 		}
 	}
 
+	if r, ok := roundZM(g, f); ok {
+		return r
+	}
+
 	panic(fmt.Sprintf("geometry type not supported: %T", g))
 }
 
@@ -1,7 +1,10 @@
 // Package simplify implements several reducing/simplifying functions for `orb.Geometry` types.
 package simplify
 
-import "github.com/paulmach/orb"
+import (
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
 
 type simplifier interface {
 	simplify(orb.LineString, bool) (orb.LineString, []int)
@@ -60,6 +63,10 @@ func simplify(s simplifier, geom orb.Geometry) orb.Geometry {
 		return g
 	}
 
+	if zm.LayoutOf(geom) != zm.XY {
+		return simplify(s, zm.ToXY(geom))
+	}
+
 	panic("unsupported type")
 }
 
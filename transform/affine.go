@@ -0,0 +1,218 @@
+// Package transform defines affine transformations, e.g. translate, scale
+// and rotate, that can be composed, inverted and applied to any orb geometry.
+package transform
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+)
+
+// ErrNotInvertible is returned when inverting a transformation that
+// collapses the plane onto a line or point, e.g. scaling by zero.
+var ErrNotInvertible = errors.New("transform: not invertible")
+
+// Affine is a 2d affine transformation matrix. The values are the first two
+// rows of the 3x3 matrix, {a, b, c, d, e, f}, so that
+//
+//	x' = a*x + b*y + c
+//	y' = d*x + e*y + f
+type Affine [6]float64
+
+// Identity is the transformation that does nothing.
+var Identity = Affine{1, 0, 0, 0, 1, 0}
+
+// Translate returns a transformation that moves points by dx and dy.
+func Translate(dx, dy float64) Affine {
+	return Affine{1, 0, dx, 0, 1, dy}
+}
+
+// Scale returns a transformation that scales points by sx and sy about the origin.
+// Use a negative factor to reflect across an axis.
+func Scale(sx, sy float64) Affine {
+	return Affine{sx, 0, 0, 0, sy, 0}
+}
+
+// ScaleAround returns a transformation that scales points by sx and sy about the center.
+func ScaleAround(sx, sy float64, center orb.Point) Affine {
+	return around(Scale(sx, sy), center)
+}
+
+// Rotate returns a transformation that rotates points counter-clockwise
+// by the angle, in degrees, about the origin.
+func Rotate(degrees float64) Affine {
+	s, c := math.Sincos(degrees * math.Pi / 180)
+
+	// snap the common angles so rotating by 90 degrees is exact
+	s, c = snap(s), snap(c)
+	return Affine{c, -s, 0, s, c, 0}
+}
+
+// RotateAround returns a transformation that rotates points counter-clockwise
+// by the angle, in degrees, about the center.
+func RotateAround(degrees float64, center orb.Point) Affine {
+	return around(Rotate(degrees), center)
+}
+
+// Skew returns a transformation that shears points by the angles, in degrees,
+// along the x and y axes about the origin.
+func Skew(xDegrees, yDegrees float64) Affine {
+	return Affine{
+		1, math.Tan(xDegrees * math.Pi / 180), 0,
+		math.Tan(yDegrees * math.Pi / 180), 1, 0,
+	}
+}
+
+// Then returns the transformation that applies this one followed by next.
+func (a Affine) Then(next Affine) Affine {
+	n := next
+	return Affine{
+		n[0]*a[0] + n[1]*a[3], n[0]*a[1] + n[1]*a[4], n[0]*a[2] + n[1]*a[5] + n[2],
+		n[3]*a[0] + n[4]*a[3], n[3]*a[1] + n[4]*a[4], n[3]*a[2] + n[4]*a[5] + n[5],
+	}
+}
+
+// Translate returns the transformation followed by a translation.
+func (a Affine) Translate(dx, dy float64) Affine {
+	return a.Then(Translate(dx, dy))
+}
+
+// Scale returns the transformation followed by scaling about the origin.
+func (a Affine) Scale(sx, sy float64) Affine {
+	return a.Then(Scale(sx, sy))
+}
+
+// Rotate returns the transformation followed by a counter-clockwise
+// rotation, in degrees, about the origin.
+func (a Affine) Rotate(degrees float64) Affine {
+	return a.Then(Rotate(degrees))
+}
+
+// Skew returns the transformation followed by a shear, in degrees,
+// about the origin.
+func (a Affine) Skew(xDegrees, yDegrees float64) Affine {
+	return a.Then(Skew(xDegrees, yDegrees))
+}
+
+// Determinant returns the determinant of the matrix. It is the factor
+// areas are scaled by, negative if the transformation reflects.
+func (a Affine) Determinant() float64 {
+	return a[0]*a[4] - a[1]*a[3]
+}
+
+// Invert returns the transformation that undoes this one.
+// Returns ErrNotInvertible if the determinant is zero.
+func (a Affine) Invert() (Affine, error) {
+	det := a.Determinant()
+	if det == 0 || math.IsNaN(det) || math.IsInf(det, 0) {
+		return Affine{}, ErrNotInvertible
+	}
+
+	return Affine{
+		a[4] / det, -a[1] / det, (a[1]*a[5] - a[2]*a[4]) / det,
+		-a[3] / det, a[0] / det, (a[2]*a[3] - a[0]*a[5]) / det,
+	}, nil
+}
+
+// Point returns the transformed point.
+func (a Affine) Point(p orb.Point) orb.Point {
+	return orb.Point{
+		a[0]*p[0] + a[1]*p[1] + a[2],
+		a[3]*p[0] + a[4]*p[1] + a[5],
+	}
+}
+
+// Projection returns the transformation as an orb.Projection so it can be
+// used with the project package.
+func (a Affine) Projection() orb.Projection {
+	return a.Point
+}
+
+// Apply transforms all the points of the geometry. The geometry is modified
+// in place, and returned, like the project package helpers. Bounds return
+// the bound around the transformed corners. Transformations with a negative
+// determinant reflect the geometry and will reverse the orientation of rings.
+func (a Affine) Apply(g orb.Geometry) orb.Geometry {
+	if g == nil {
+		return nil
+	}
+
+	switch g := g.(type) {
+	case orb.Point:
+		return a.Point(g)
+	case orb.MultiPoint:
+		return orb.MultiPoint(a.points(g))
+	case orb.LineString:
+		return orb.LineString(a.points(g))
+	case orb.MultiLineString:
+		for i := range g {
+			a.points(g[i])
+		}
+		return g
+	case orb.Ring:
+		return orb.Ring(a.points(g))
+	case orb.Polygon:
+		a.polygon(g)
+		return g
+	case orb.MultiPolygon:
+		for _, p := range g {
+			a.polygon(p)
+		}
+		return g
+	case orb.Collection:
+		for i := range g {
+			g[i] = a.Apply(g[i])
+		}
+		return g
+	case orb.Bound:
+		if g.IsEmpty() {
+			return g
+		}
+
+		min := a.Point(g.Min)
+		b := orb.Bound{Min: min, Max: min}
+		b = b.Extend(a.Point(g.Max))
+		b = b.Extend(a.Point(orb.Point{g.Min[0], g.Max[1]}))
+		return b.Extend(a.Point(orb.Point{g.Max[0], g.Min[1]}))
+	}
+
+	if zm.LayoutOf(g) != zm.XY {
+		return a.Apply(zm.ToXY(g))
+	}
+
+	panic(fmt.Sprintf("geometry type not supported: %T", g))
+}
+
+func (a Affine) points(ps []orb.Point) []orb.Point {
+	for i := range ps {
+		ps[i] = a.Point(ps[i])
+	}
+
+	return ps
+}
+
+func (a Affine) polygon(p orb.Polygon) {
+	for _, r := range p {
+		a.points(r)
+	}
+}
+
+// around returns the transformation applied about the center point
+// instead of the origin.
+func around(a Affine, center orb.Point) Affine {
+	return Translate(-center[0], -center[1]).Then(a).Translate(center[0], center[1])
+}
+
+// snap rounds values very close to -1, 0 and 1 to those values.
+func snap(v float64) float64 {
+	for _, s := range []float64{-1, 0, 1} {
+		if math.Abs(v-s) < 1e-15 {
+			return s
+		}
+	}
+
+	return v
+}
@@ -0,0 +1,206 @@
+package transform
+
+import (
+	"math"
+	"testing"
+
+	"github.com/paulmach/orb"
+)
+
+func TestAffine_Point(t *testing.T) {
+	cases := []struct {
+		name     string
+		affine   Affine
+		point    orb.Point
+		expected orb.Point
+	}{
+		{
+			name:     "identity",
+			affine:   Identity,
+			point:    orb.Point{1, 2},
+			expected: orb.Point{1, 2},
+		},
+		{
+			name:     "translate",
+			affine:   Translate(10, -5),
+			point:    orb.Point{1, 2},
+			expected: orb.Point{11, -3},
+		},
+		{
+			name:     "scale",
+			affine:   Scale(2, -3),
+			point:    orb.Point{1, 2},
+			expected: orb.Point{2, -6},
+		},
+		{
+			name:     "scale around",
+			affine:   ScaleAround(2, 2, orb.Point{1, 1}),
+			point:    orb.Point{2, 3},
+			expected: orb.Point{3, 5},
+		},
+		{
+			name:     "rotate",
+			affine:   Rotate(90),
+			point:    orb.Point{1, 0},
+			expected: orb.Point{0, 1},
+		},
+		{
+			name:     "rotate around",
+			affine:   RotateAround(180, orb.Point{1, 1}),
+			point:    orb.Point{2, 1},
+			expected: orb.Point{0, 1},
+		},
+		{
+			name:     "skew",
+			affine:   Skew(45, 0),
+			point:    orb.Point{0, 2},
+			expected: orb.Point{2, 2},
+		},
+		{
+			name:     "compose",
+			affine:   Scale(2, 2).Translate(1, 0).Rotate(90),
+			point:    orb.Point{1, 0},
+			expected: orb.Point{0, 3},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := tc.affine.Point(tc.point)
+			if math.Abs(p[0]-tc.expected[0]) > 1e-12 || math.Abs(p[1]-tc.expected[1]) > 1e-12 {
+				t.Errorf("incorrect point: %v != %v", p, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAffine_Invert(t *testing.T) {
+	a := Rotate(30).Scale(2, 3).Skew(10, 20).Translate(5, -7)
+
+	inv, err := a.Invert()
+	if err != nil {
+		t.Fatalf("invert error: %v", err)
+	}
+
+	for _, p := range []orb.Point{{0, 0}, {1, 2}, {-100, 40}} {
+		r := inv.Point(a.Point(p))
+		if math.Abs(r[0]-p[0]) > 1e-9 || math.Abs(r[1]-p[1]) > 1e-9 {
+			t.Errorf("incorrect round trip: %v != %v", r, p)
+		}
+	}
+
+	id := a.Then(inv)
+	for i := range id {
+		if math.Abs(id[i]-Identity[i]) > 1e-12 {
+			t.Errorf("should be identity: %v", id)
+		}
+	}
+
+	_, err = Scale(0, 1).Invert()
+	if err != ErrNotInvertible {
+		t.Errorf("incorrect error: %v", err)
+	}
+}
+
+func TestAffine_Determinant(t *testing.T) {
+	if d := Scale(2, 3).Determinant(); d != 6 {
+		t.Errorf("incorrect determinant: %v", d)
+	}
+
+	if d := Rotate(33).Translate(4, 5).Determinant(); math.Abs(d-1) > 1e-12 {
+		t.Errorf("incorrect determinant: %v", d)
+	}
+
+	if d := Scale(-1, 1).Determinant(); d != -1 {
+		t.Errorf("incorrect determinant: %v", d)
+	}
+}
+
+func TestAffine_Apply(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		// should not panic with supported types
+		Identity.Apply(orb.Clone(g))
+	}
+
+	a := Translate(1, 2)
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		expected orb.Geometry
+	}{
+		{
+			name:     "nil",
+			geom:     nil,
+			expected: nil,
+		},
+		{
+			name:     "point",
+			geom:     orb.Point{1, 1},
+			expected: orb.Point{2, 3},
+		},
+		{
+			name:     "multi point",
+			geom:     orb.MultiPoint{{1, 1}, {2, 2}},
+			expected: orb.MultiPoint{{2, 3}, {3, 4}},
+		},
+		{
+			name:     "line string",
+			geom:     orb.LineString{{1, 1}, {2, 2}},
+			expected: orb.LineString{{2, 3}, {3, 4}},
+		},
+		{
+			name:     "multi line string",
+			geom:     orb.MultiLineString{{{1, 1}}, {{2, 2}}},
+			expected: orb.MultiLineString{{{2, 3}}, {{3, 4}}},
+		},
+		{
+			name:     "ring",
+			geom:     orb.Ring{{0, 0}, {1, 0}, {0, 0}},
+			expected: orb.Ring{{1, 2}, {2, 2}, {1, 2}},
+		},
+		{
+			name:     "polygon",
+			geom:     orb.Polygon{{{0, 0}, {1, 0}, {0, 0}}},
+			expected: orb.Polygon{{{1, 2}, {2, 2}, {1, 2}}},
+		},
+		{
+			name:     "multi polygon",
+			geom:     orb.MultiPolygon{{{{0, 0}, {1, 0}, {0, 0}}}},
+			expected: orb.MultiPolygon{{{{1, 2}, {2, 2}, {1, 2}}}},
+		},
+		{
+			name:     "collection",
+			geom:     orb.Collection{orb.Point{0, 0}, orb.Bound{Max: orb.Point{1, 1}}},
+			expected: orb.Collection{orb.Point{1, 2}, orb.Bound{Min: orb.Point{1, 2}, Max: orb.Point{2, 3}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			g := a.Apply(tc.geom)
+			if g == nil && tc.expected == nil {
+				return
+			}
+
+			if !orb.Equal(g, tc.expected) {
+				t.Errorf("incorrect geometry: %v != %v", g, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAffine_ApplyBound(t *testing.T) {
+	b := orb.Bound{Min: orb.Point{0, 0}, Max: orb.Point{2, 1}}
+
+	r := Rotate(90).Apply(b).(orb.Bound)
+	expected := orb.Bound{Min: orb.Point{-1, 0}, Max: orb.Point{0, 2}}
+	if !r.Equal(expected) {
+		t.Errorf("incorrect bound: %v != %v", r, expected)
+	}
+
+	// rotating 45 degrees needs all the corners
+	r = Rotate(45).Apply(b).(orb.Bound)
+	if math.Abs(r.Min[0]+math.Sqrt2/2) > 1e-12 || math.Abs(r.Max[1]-3*math.Sqrt2/2) > 1e-12 {
+		t.Errorf("incorrect bound: %v", r)
+	}
+}
@@ -0,0 +1,22 @@
+package transform_test
+
+import (
+	"fmt"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/transform"
+)
+
+func ExampleAffine_Apply() {
+	room := orb.Polygon{{{0, 0}, {4, 0}, {4, 2}, {0, 2}, {0, 0}}}
+
+	// rotate the floor plan about its corner, then move it into place
+	a := transform.RotateAround(90, orb.Point{0, 0}).Translate(10, 5)
+	fmt.Println(a.Apply(room))
+
+	inv, _ := a.Invert()
+	fmt.Println(inv.Apply(room))
+	// Output:
+	// [[[10 5] [10 9] [8 9] [8 5] [10 5]]]
+	// [[[0 0] [4 0] [4 2] [0 2] [0 0]]]
+}
@@ -0,0 +1,38 @@
+package validate_test
+
+import (
+	"fmt"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+	"github.com/paulmach/orb/validate"
+)
+
+func ExampleIssues() {
+	bowtie := orb.Polygon{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}}
+
+	for _, issue := range validate.Issues(bowtie) {
+		fmt.Println(issue.Reason, issue.Location)
+	}
+	// Output:
+	// ring self-intersection [1 1]
+}
+
+func ExampleValid() {
+	cw := orb.Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}, {0, 0}}}
+
+	fmt.Println(validate.Valid(cw))
+	fmt.Println(validate.Valid(cw, validate.Orientation(orb.CCW)))
+	// Output:
+	// true
+	// false
+}
+
+func ExampleMakeValid() {
+	bowtie := orb.Polygon{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}}
+
+	mp := validate.MakeValid(bowtie)
+	fmt.Println(len(mp), planar.Area(mp))
+	// Output:
+	// 2 2
+}
@@ -0,0 +1,166 @@
+package validate
+
+import (
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/segments"
+	"github.com/paulmach/orb/internal/zm"
+	"github.com/paulmach/orb/planar"
+)
+
+// MakeValid repairs the areas of the geometry, i.e. rings, polygons,
+// multi-polygons and bounds, and returns a valid multi-polygon covering
+// the same area. Self-intersecting rings, like bow-ties, are split into
+// simple rings and the area enclosed by any part of the ring is kept.
+// Overlapping polygons are dissolved, the parts of holes outside their
+// shell are ignored and zero-area slivers are dropped. Invalid coordinates
+// and repeated points are removed and unclosed rings are closed.
+// The result will have outer rings in counter-clockwise order and holes
+// in clockwise order, as required by RFC 7946.
+func MakeValid(g orb.Geometry) orb.MultiPolygon {
+	var areas orb.MultiPolygon
+	collectAreas(g, &areas)
+
+	return planar.Union(areas, nil)
+}
+
+func collectAreas(g orb.Geometry, areas *orb.MultiPolygon) {
+	switch g := g.(type) {
+	case orb.Ring:
+		*areas = append(*areas, fixPolygon(orb.Polygon{g})...)
+	case orb.Polygon:
+		*areas = append(*areas, fixPolygon(g)...)
+	case orb.MultiPolygon:
+		for _, p := range g {
+			*areas = append(*areas, fixPolygon(p)...)
+		}
+	case orb.Collection:
+		for _, c := range g {
+			collectAreas(c, areas)
+		}
+	case orb.Bound:
+		*areas = append(*areas, fixPolygon(g.ToPolygon())...)
+	default:
+		if zm.LayoutOf(g) != zm.XY {
+			collectAreas(zm.ToXY(g), areas)
+		}
+	}
+}
+
+// fixPolygon returns the area of the shell minus the area of the holes.
+func fixPolygon(p orb.Polygon) orb.MultiPolygon {
+	if len(p) == 0 {
+		return nil
+	}
+
+	shell := planar.Union(ringArea(p[0]), nil)
+	if len(p) == 1 || len(shell) == 0 {
+		return shell
+	}
+
+	var holes orb.MultiPolygon
+	for _, r := range p[1:] {
+		holes = append(holes, ringArea(r)...)
+	}
+
+	return planar.Difference(shell, holes)
+}
+
+// ringArea splits the ring into simple rings at the points where
+// it touches or crosses itself.
+func ringArea(r orb.Ring) orb.MultiPolygon {
+	points := make([]orb.Point, 0, len(r)+1)
+	for _, p := range r {
+		if !isFinite(p[0]) || !isFinite(p[1]) {
+			continue
+		}
+
+		if len(points) == 0 || p != points[len(points)-1] {
+			points = append(points, p)
+		}
+	}
+
+	if len(points) < 3 {
+		return nil
+	}
+
+	if points[0] != points[len(points)-1] {
+		points = append(points, points[0])
+	}
+
+	var result orb.MultiPolygon
+	for _, loop := range splitLoops(nodeLine(points)) {
+		if len(loop) >= 4 && loop.Orientation() != 0 {
+			result = append(result, orb.Polygon{loop})
+		}
+	}
+
+	return result
+}
+
+// nodeLine adds the points where the line crosses or touches itself
+// as vertices of the line.
+func nodeLine(points []orb.Point) []orb.Point {
+	nodes := make([][]orb.Point, len(points)-1)
+	add := func(i int, p orb.Point) {
+		if p != points[i] && p != points[i+1] {
+			nodes[i] = append(nodes[i], p)
+		}
+	}
+
+	forEachPair(points, func(i, j int) bool {
+		for _, p := range segments.Intersection(points[i], points[i+1], points[j], points[j+1]) {
+			add(i, p)
+			add(j, p)
+		}
+		return true
+	})
+
+	result := make([]orb.Point, 0, len(points))
+	for i := 0; i < len(points)-1; i++ {
+		result = append(result, points[i])
+
+		a := points[i]
+		ns := nodes[i]
+		sort.Slice(ns, func(i, j int) bool {
+			return sqDist(a, ns[i]) < sqDist(a, ns[j])
+		})
+
+		for _, p := range ns {
+			if p != result[len(result)-1] {
+				result = append(result, p)
+			}
+		}
+	}
+
+	return append(result, points[len(points)-1])
+}
+
+// splitLoops walks the closed line and cuts out a ring
+// every time it comes back to a point it has already visited.
+func splitLoops(points []orb.Point) []orb.Ring {
+	var result []orb.Ring
+
+	stack := make([]orb.Point, 0, len(points))
+	index := make(map[orb.Point]int, len(points))
+	for _, p := range points {
+		i, ok := index[p]
+		if !ok {
+			index[p] = len(stack)
+			stack = append(stack, p)
+			continue
+		}
+
+		loop := make(orb.Ring, 0, len(stack)-i+1)
+		loop = append(loop, stack[i:]...)
+		result = append(result, append(loop, p))
+
+		for _, q := range stack[i+1:] {
+			delete(index, q)
+		}
+		stack = stack[:i+1]
+	}
+
+	return result
+}
@@ -0,0 +1,19 @@
+package validate
+
+import "github.com/paulmach/orb"
+
+type options struct {
+	orientation orb.Orientation
+}
+
+// An Option is a possible parameter to the validation.
+type Option func(*options)
+
+// Orientation requires the outer rings of polygons to have the orientation
+// and the holes to have the opposite. RFC 7946 GeoJSON requires orb.CCW.
+// By default the orientation is not checked.
+func Orientation(o orb.Orientation) Option {
+	return func(opts *options) {
+		opts.orientation = o
+	}
+}
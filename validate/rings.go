@@ -0,0 +1,130 @@
+package validate
+
+import (
+	"math"
+	"sort"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/segments"
+)
+
+// ringSelfIntersection returns a point where the closed ring crosses or
+// touches itself. Adjacent segments are only an intersection if they
+// double back over each other. Repeated points are ignored.
+func ringSelfIntersection(r orb.Ring) (orb.Point, bool) {
+	points := make([]orb.Point, 0, len(r))
+	for i, p := range r {
+		if i == 0 || p != r[i-1] {
+			points = append(points, p)
+		}
+	}
+
+	n := len(points) - 1
+	adjacent := func(i, j int) bool {
+		d := i - j
+		return d == 1 || d == -1 || d == n-1 || d == 1-n
+	}
+
+	var (
+		result orb.Point
+		found  bool
+	)
+	forEachPair(points, func(i, j int) bool {
+		a1, a2 := points[i], points[i+1]
+		b1, b2 := points[j], points[j+1]
+
+		if adjacent(i, j) {
+			result, found = doublesBack(a1, a2, b1, b2)
+		} else if ps := segments.Intersection(a1, a2, b1, b2); len(ps) > 0 {
+			result, found = ps[0], true
+		}
+
+		return !found
+	})
+
+	return result, found
+}
+
+// forEachPair calls the function for every pair of segments of the line
+// that overlap in the x direction. It will stop if the function returns false.
+func forEachPair(points []orb.Point, f func(i, j int) bool) {
+	n := len(points) - 1
+	if n < 1 {
+		return
+	}
+
+	// sweep the segments from left to right
+	order := make([]int, n)
+	for i := range order {
+		order[i] = i
+	}
+	sort.Slice(order, func(i, j int) bool {
+		return minX(points, order[i]) < minX(points, order[j])
+	})
+
+	var active []int
+	for _, i := range order {
+		x := minX(points, i)
+
+		k := 0
+		for _, j := range active {
+			if maxX(points, j) >= x {
+				active[k] = j
+				k++
+			}
+		}
+		active = active[:k]
+
+		for _, j := range active {
+			if !f(i, j) {
+				return
+			}
+		}
+
+		active = append(active, i)
+	}
+}
+
+// doublesBack checks if two segments that share an endpoint
+// overlap and returns the end of the overlap.
+func doublesBack(a1, a2, b1, b2 orb.Point) (orb.Point, bool) {
+	var v, p, q orb.Point
+	switch {
+	case a2 == b1:
+		v, p, q = a2, a1, b2
+	case a1 == b2:
+		v, p, q = a1, a2, b1
+	case a1 == b1:
+		v, p, q = a1, a2, b2
+	default:
+		v, p, q = a2, a1, b1
+	}
+
+	if segments.Orient(p, v, q) != 0 {
+		return orb.Point{}, false
+	}
+
+	if (p[0]-v[0])*(q[0]-v[0])+(p[1]-v[1])*(q[1]-v[1]) <= 0 {
+		return orb.Point{}, false
+	}
+
+	if sqDist(v, p) < sqDist(v, q) {
+		return p, true
+	}
+
+	return q, true
+}
+
+func sqDist(a, b orb.Point) float64 {
+	dx := a[0] - b[0]
+	dy := a[1] - b[1]
+	return dx*dx + dy*dy
+}
+
+func minX(points []orb.Point, i int) float64 {
+	return math.Min(points[i][0], points[i+1][0])
+}
+
+func maxX(points []orb.Point, i int) float64 {
+	return math.Max(points[i][0], points[i+1][0])
+}
@@ -0,0 +1,447 @@
+// Package validate checks geometries for the issues that make them
+// invalid according to the OGC Simple Features specification and RFC 7946.
+// The geometries are assumed to be in 2d euclidean space.
+package validate
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/internal/zm"
+	"github.com/paulmach/orb/planar"
+)
+
+// Reason is the kind of problem that makes a geometry invalid.
+type Reason int
+
+// The possible reasons for a geometry to be invalid.
+const (
+	// InvalidCoordinate is a coordinate that is NaN or infinite.
+	InvalidCoordinate Reason = iota + 1
+
+	// RepeatedPoint is a point that is the same as the one before it.
+	RepeatedPoint
+
+	// TooFewPoints is a line with less than 2 distinct points or
+	// a ring with less than 3.
+	TooFewPoints
+
+	// RingNotClosed is a ring where the first and last points are different.
+	RingNotClosed
+
+	// RingSelfIntersection is a ring that crosses or touches itself.
+	RingSelfIntersection
+
+	// SelfIntersection is two rings of a polygon, or two polygons of a
+	// multi-polygon, that cross or share a section of their boundary.
+	SelfIntersection
+
+	// HoleOutsideShell is a hole that is not inside the outer ring of the polygon.
+	HoleOutsideShell
+
+	// NestedHoles is a hole that overlaps another hole of the same polygon.
+	NestedHoles
+
+	// NestedShells is a polygon of a multi-polygon that overlaps another.
+	NestedShells
+
+	// DisconnectedInterior is two rings that touch at more than one point,
+	// splitting the interior of the polygon in two.
+	DisconnectedInterior
+
+	// WrongOrientation is a ring that is not in the orientation
+	// required by the Orientation option.
+	WrongOrientation
+)
+
+var reasonStrings = map[Reason]string{
+	InvalidCoordinate:    "invalid coordinate",
+	RepeatedPoint:        "repeated point",
+	TooFewPoints:         "too few points",
+	RingNotClosed:        "ring not closed",
+	RingSelfIntersection: "ring self-intersection",
+	SelfIntersection:     "self-intersection",
+	HoleOutsideShell:     "hole outside shell",
+	NestedHoles:          "nested holes",
+	NestedShells:         "nested shells",
+	DisconnectedInterior: "disconnected interior",
+	WrongOrientation:     "wrong orientation",
+}
+
+// String returns a human readable description of the reason.
+func (r Reason) String() string {
+	if s, ok := reasonStrings[r]; ok {
+		return s
+	}
+
+	return fmt.Sprintf("unknown reason %d", int(r))
+}
+
+// Issue is a single problem found in a geometry.
+type Issue struct {
+	Reason Reason
+
+	// Location is the point where the problem was found.
+	Location orb.Point
+
+	// Path is the indexes into the geometry of the part with the problem,
+	// e.g. [polygon, ring, point] for a multi-polygon. It is prefixed by the
+	// index of the geometry for collections and can be shorter if the problem
+	// is with the whole ring or polygon.
+	Path []int
+}
+
+// Error returns the issue as a string so it can be used as an error.
+func (i Issue) Error() string {
+	return fmt.Sprintf("validate: %v at %v, path %v", i.Reason, i.Location, i.Path)
+}
+
+// Valid returns true if the geometry has no issues.
+func Valid(g orb.Geometry, opts ...Option) bool {
+	return len(Issues(g, opts...)) == 0
+}
+
+// Issues returns all the problems found in the geometry. Each ring and
+// polygon is checked for the simpler problems first, i.e. invalid coordinates,
+// too few points and unclosed rings, and the topology checks are only done
+// if those pass. Rings that intersect themselves are also not checked
+// against the other rings.
+func Issues(g orb.Geometry, opts ...Option) []Issue {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+
+	v := &validator{opts: o}
+	v.geometry(g, nil)
+
+	return v.issues
+}
+
+type validator struct {
+	opts   *options
+	issues []Issue
+}
+
+func (v *validator) add(r Reason, loc orb.Point, path []int) {
+	v.issues = append(v.issues, Issue{
+		Reason:   r,
+		Location: loc,
+		Path:     append([]int(nil), path...),
+	})
+}
+
+func (v *validator) geometry(g orb.Geometry, path []int) {
+	if g == nil {
+		return
+	}
+
+	switch g := g.(type) {
+	case orb.Point:
+		v.coordinates([]orb.Point{g}, path)
+	case orb.MultiPoint:
+		for i, p := range g {
+			v.coordinates([]orb.Point{p}, append(path, i))
+		}
+	case orb.LineString:
+		v.lineString(g, path)
+	case orb.MultiLineString:
+		for i, ls := range g {
+			v.lineString(ls, append(path, i))
+		}
+	case orb.Ring:
+		v.polygon(orb.Polygon{g}, path, true)
+	case orb.Polygon:
+		v.polygon(g, path, false)
+	case orb.MultiPolygon:
+		v.multiPolygon(g, path)
+	case orb.Collection:
+		for i, c := range g {
+			v.geometry(c, append(path, i))
+		}
+	case orb.Bound:
+		v.coordinates([]orb.Point{g.Min, g.Max}, path)
+	default:
+		if zm.LayoutOf(g) != zm.XY {
+			v.geometry(zm.ToXY(g), path)
+			return
+		}
+
+		panic(fmt.Sprintf("geometry type not supported: %T", g))
+	}
+}
+
+// coordinates checks that every coordinate is a finite number.
+func (v *validator) coordinates(points []orb.Point, path []int) bool {
+	valid := true
+	for i, p := range points {
+		if isFinite(p[0]) && isFinite(p[1]) {
+			continue
+		}
+
+		valid = false
+		if len(points) == 1 {
+			v.add(InvalidCoordinate, p, path)
+		} else {
+			v.add(InvalidCoordinate, p, append(path, i))
+		}
+	}
+
+	return valid
+}
+
+// repeated reports the repeated consecutive points and returns
+// the number of distinct consecutive points.
+func (v *validator) repeated(points []orb.Point, path []int) int {
+	count := 0
+	for i, p := range points {
+		if i > 0 && p == points[i-1] {
+			v.add(RepeatedPoint, p, append(path, i))
+			continue
+		}
+		count++
+	}
+
+	return count
+}
+
+func (v *validator) lineString(ls orb.LineString, path []int) {
+	if len(ls) == 0 {
+		return
+	}
+
+	if !v.coordinates(ls, path) {
+		return
+	}
+
+	if v.repeated(ls, path) < 2 {
+		v.add(TooFewPoints, ls[0], path)
+	}
+}
+
+// ring checks the ring by itself and returns true if
+// it can be used for the checks between rings.
+func (v *validator) ring(r orb.Ring, path []int) bool {
+	if len(r) == 0 {
+		v.add(TooFewPoints, orb.Point{}, path)
+		return false
+	}
+
+	if !v.coordinates(r, path) {
+		return false
+	}
+
+	count := v.repeated(r, path)
+
+	closed := r[0] == r[len(r)-1]
+	if closed {
+		count--
+	} else {
+		v.add(RingNotClosed, r[len(r)-1], append(path, len(r)-1))
+	}
+
+	if count < 3 {
+		v.add(TooFewPoints, r[0], path)
+		return false
+	}
+
+	if !closed {
+		return false
+	}
+
+	if p, ok := ringSelfIntersection(r); ok {
+		v.add(RingSelfIntersection, p, path)
+		return false
+	}
+
+	return true
+}
+
+// polygon checks the rings of the polygon and how they relate to each other.
+// If single is true the polygon is a ring and the path does not include
+// the ring index.
+func (v *validator) polygon(p orb.Polygon, path []int, single bool) bool {
+	valid := true
+	for i, r := range p {
+		rp := path
+		if !single {
+			rp = append(path, i)
+		}
+
+		if !v.ring(r, rp) {
+			valid = false
+		}
+	}
+
+	if !valid || len(p) == 0 {
+		return false
+	}
+
+	if !v.holes(p, path) {
+		valid = false
+	}
+
+	if o := v.opts.orientation; o != 0 {
+		for i, r := range p {
+			expected := o
+			if i > 0 {
+				expected = -o
+			}
+
+			if r.Orientation() == expected {
+				continue
+			}
+
+			if single {
+				v.add(WrongOrientation, r[0], path)
+			} else {
+				v.add(WrongOrientation, r[0], append(path, i))
+			}
+		}
+	}
+
+	return valid
+}
+
+// holes checks that the holes are inside the shell, do not overlap
+// and only touch the other rings at single points.
+func (v *validator) holes(p orb.Polygon, path []int) bool {
+	valid := true
+	shell := orb.Polygon{p[0]}
+
+	for i := 1; i < len(p); i++ {
+		hole := orb.Polygon{p[i]}
+		hp := append(path, i)
+
+		if !p[0].Bound().Intersects(p[i].Bound()) {
+			v.add(HoleOutsideShell, p[i][0], hp)
+			valid = false
+			continue
+		}
+
+		if point, ok := outside(p[0], p[i]); ok {
+			v.add(HoleOutsideShell, point, hp)
+			valid = false
+			continue
+		}
+
+		if !planar.CoveredBy(hole, shell) {
+			v.add(SelfIntersection, firstIntersection(p[0], p[i]), hp)
+			valid = false
+			continue
+		}
+
+		if !v.touching(p[0], p[i], hp) {
+			valid = false
+		}
+	}
+
+	for i := 1; i < len(p); i++ {
+		for j := i + 1; j < len(p); j++ {
+			if !p[i].Bound().Intersects(p[j].Bound()) {
+				continue
+			}
+
+			hp := append(path, j)
+			im := planar.Relate(orb.Polygon{p[i]}, orb.Polygon{p[j]})
+			if im.Get(planar.Interior, planar.Interior) == 2 {
+				v.add(NestedHoles, overlapLocation(p[i], p[j]), hp)
+				valid = false
+				continue
+			}
+
+			if !v.touching(p[i], p[j], hp) {
+				valid = false
+			}
+		}
+	}
+
+	return valid
+}
+
+func (v *validator) multiPolygon(mp orb.MultiPolygon, path []int) {
+	valid := true
+	for i, p := range mp {
+		if !v.polygon(p, append(path, i), false) {
+			valid = false
+		}
+	}
+
+	if !valid {
+		return
+	}
+
+	for i := range mp {
+		if len(mp[i]) == 0 {
+			continue
+		}
+
+		for j := i + 1; j < len(mp); j++ {
+			if len(mp[j]) == 0 || !mp[i].Bound().Intersects(mp[j].Bound()) {
+				continue
+			}
+
+			pp := append(path, j)
+			im := planar.Relate(mp[i], mp[j])
+			if im.Get(planar.Interior, planar.Interior) == 2 {
+				v.add(NestedShells, overlapLocation(mp[i][0], mp[j][0]), pp)
+				continue
+			}
+
+			if im.Get(planar.Boundary, planar.Boundary) == 1 {
+				v.add(SelfIntersection, firstIntersection(mp[i][0], mp[j][0]), pp)
+			}
+		}
+	}
+}
+
+// touching checks that two rings with interiors that do not overlap
+// only touch at a single point.
+func (v *validator) touching(a, b orb.Ring, path []int) bool {
+	im := planar.Relate(orb.LineString(a), orb.LineString(b))
+	if im.Get(planar.Interior, planar.Interior) == 1 {
+		v.add(SelfIntersection, firstIntersection(a, b), path)
+		return false
+	}
+
+	if points := planar.Intersections(orb.LineString(a), orb.LineString(b)); len(points) > 1 {
+		v.add(DisconnectedInterior, points[1], path)
+		return false
+	}
+
+	return true
+}
+
+// outside returns the first point of the ring r that is outside the shell.
+func outside(shell, r orb.Ring) (orb.Point, bool) {
+	for _, p := range r {
+		if !planar.RingContains(shell, p) {
+			return p, true
+		}
+	}
+
+	return orb.Point{}, false
+}
+
+// overlapLocation returns a point where the rings overlap, either where
+// they cross or a point of one ring inside the other.
+func overlapLocation(a, b orb.Ring) orb.Point {
+	if points := planar.Intersections(orb.LineString(a), orb.LineString(b)); len(points) > 0 {
+		return points[0]
+	}
+
+	return b[0]
+}
+
+func firstIntersection(a, b orb.Ring) orb.Point {
+	if points := planar.Intersections(orb.LineString(a), orb.LineString(b)); len(points) > 0 {
+		return points[0]
+	}
+
+	return a[0]
+}
+
+func isFinite(f float64) bool {
+	return !math.IsNaN(f) && !math.IsInf(f, 0)
+}
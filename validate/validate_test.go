@@ -0,0 +1,386 @@
+package validate
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/paulmach/orb"
+	"github.com/paulmach/orb/planar"
+)
+
+func TestIssues(t *testing.T) {
+	square := orb.Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+
+	cases := []struct {
+		name   string
+		geom   orb.Geometry
+		opts   []Option
+		issues []Issue
+	}{
+		{
+			name: "valid polygon",
+			geom: orb.Polygon{square, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}},
+		},
+		{
+			name: "nan point",
+			geom: orb.Point{math.NaN(), 1},
+			issues: []Issue{
+				{Reason: InvalidCoordinate, Location: orb.Point{math.NaN(), 1}},
+			},
+		},
+		{
+			name: "inf in line",
+			geom: orb.LineString{{0, 0}, {math.Inf(1), 1}},
+			issues: []Issue{
+				{Reason: InvalidCoordinate, Location: orb.Point{math.Inf(1), 1}, Path: []int{1}},
+			},
+		},
+		{
+			name: "repeated point",
+			geom: orb.LineString{{0, 0}, {1, 1}, {1, 1}, {2, 0}},
+			issues: []Issue{
+				{Reason: RepeatedPoint, Location: orb.Point{1, 1}, Path: []int{2}},
+			},
+		},
+		{
+			name: "short line",
+			geom: orb.MultiLineString{{{0, 0}, {1, 1}}, {{1, 1}, {1, 1}}},
+			issues: []Issue{
+				{Reason: RepeatedPoint, Location: orb.Point{1, 1}, Path: []int{1, 1}},
+				{Reason: TooFewPoints, Location: orb.Point{1, 1}, Path: []int{1}},
+			},
+		},
+		{
+			name: "ring not closed",
+			geom: orb.Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 1}}},
+			issues: []Issue{
+				{Reason: RingNotClosed, Location: orb.Point{0, 1}, Path: []int{0, 3}},
+			},
+		},
+		{
+			name: "too few points",
+			geom: orb.Polygon{{{0, 0}, {1, 0}, {0, 0}}},
+			issues: []Issue{
+				{Reason: TooFewPoints, Location: orb.Point{0, 0}, Path: []int{0}},
+			},
+		},
+		{
+			name: "bow-tie",
+			geom: orb.Ring{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}},
+			issues: []Issue{
+				{Reason: RingSelfIntersection, Location: orb.Point{1, 1}},
+			},
+		},
+		{
+			name: "ring touches itself",
+			geom: orb.Polygon{{{0, 0}, {4, 0}, {2, 2}, {3, 4}, {1, 4}, {2, 2}, {0, 0}}},
+			issues: []Issue{
+				{Reason: RingSelfIntersection, Location: orb.Point{2, 2}, Path: []int{0}},
+			},
+		},
+		{
+			name: "spike",
+			geom: orb.Polygon{{{0, 0}, {4, 0}, {6, 0}, {4, 0}, {4, 4}, {0, 0}}},
+			issues: []Issue{
+				{Reason: RingSelfIntersection, Location: orb.Point{4, 0}, Path: []int{0}},
+			},
+		},
+		{
+			name: "hole outside shell",
+			geom: orb.Polygon{square, {{12, 2}, {12, 4}, {14, 4}, {14, 2}, {12, 2}}},
+			issues: []Issue{
+				{Reason: HoleOutsideShell, Location: orb.Point{12, 2}, Path: []int{1}},
+			},
+		},
+		{
+			name: "hole crosses shell",
+			geom: orb.Polygon{square, {{8, 2}, {8, 4}, {12, 4}, {12, 2}, {8, 2}}},
+			issues: []Issue{
+				{Reason: HoleOutsideShell, Location: orb.Point{12, 4}, Path: []int{1}},
+			},
+		},
+		{
+			name: "hole touches shell at one point",
+			geom: orb.Polygon{square, {{0, 5}, {2, 6}, {2, 4}, {0, 5}}},
+		},
+		{
+			name: "hole shares edge with shell",
+			geom: orb.Polygon{square, {{0, 4}, {0, 6}, {2, 6}, {2, 4}, {0, 4}}},
+			issues: []Issue{
+				{Reason: SelfIntersection, Location: orb.Point{0, 4}, Path: []int{1}},
+			},
+		},
+		{
+			name: "hole touches shell at two points",
+			geom: orb.Polygon{square, {{0, 5}, {5, 10}, {5, 5}, {0, 5}}},
+			issues: []Issue{
+				{Reason: DisconnectedInterior, Location: orb.Point{5, 10}, Path: []int{1}},
+			},
+		},
+		{
+			name: "overlapping holes",
+			geom: orb.Polygon{
+				square,
+				{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}},
+				{{3, 3}, {3, 5}, {5, 5}, {5, 3}, {3, 3}},
+			},
+			issues: []Issue{
+				{Reason: NestedHoles, Location: orb.Point{3, 4}, Path: []int{2}},
+			},
+		},
+		{
+			name: "nested holes",
+			geom: orb.Polygon{
+				square,
+				{{1, 1}, {1, 5}, {5, 5}, {5, 1}, {1, 1}},
+				{{2, 2}, {2, 3}, {3, 3}, {3, 2}, {2, 2}},
+			},
+			issues: []Issue{
+				{Reason: NestedHoles, Location: orb.Point{2, 2}, Path: []int{2}},
+			},
+		},
+		{
+			name: "overlapping polygons",
+			geom: orb.MultiPolygon{
+				{square},
+				{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}},
+			},
+			issues: []Issue{
+				{Reason: NestedShells, Location: orb.Point{5, 10}, Path: []int{1}},
+			},
+		},
+		{
+			name: "polygons share an edge",
+			geom: orb.MultiPolygon{
+				{square},
+				{{{10, 0}, {20, 0}, {20, 10}, {10, 10}, {10, 0}}},
+			},
+			issues: []Issue{
+				{Reason: SelfIntersection, Location: orb.Point{10, 0}, Path: []int{1}},
+			},
+		},
+		{
+			name: "polygons touch at a point",
+			geom: orb.MultiPolygon{
+				{square},
+				{{{10, 10}, {20, 10}, {20, 20}, {10, 10}}},
+			},
+		},
+		{
+			name: "polygon in a hole",
+			geom: orb.MultiPolygon{
+				{square, {{2, 2}, {2, 8}, {8, 8}, {8, 2}, {2, 2}}},
+				{{{4, 4}, {6, 4}, {6, 6}, {4, 6}, {4, 4}}},
+			},
+		},
+		{
+			name: "wrong orientation",
+			geom: orb.Polygon{
+				{{0, 0}, {0, 10}, {10, 10}, {10, 0}, {0, 0}},
+				{{2, 2}, {4, 2}, {4, 4}, {2, 4}, {2, 2}},
+			},
+			opts: []Option{Orientation(orb.CCW)},
+			issues: []Issue{
+				{Reason: WrongOrientation, Location: orb.Point{0, 0}, Path: []int{0}},
+				{Reason: WrongOrientation, Location: orb.Point{2, 2}, Path: []int{1}},
+			},
+		},
+		{
+			name: "collection",
+			geom: orb.Collection{
+				orb.Point{1, 2},
+				orb.MultiPolygon{{square}, {{{0, 0}, {1, 0}, {0, 0}}}},
+			},
+			issues: []Issue{
+				{Reason: TooFewPoints, Location: orb.Point{0, 0}, Path: []int{1, 1, 0}},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			issues := Issues(tc.geom, tc.opts...)
+			if len(issues) != len(tc.issues) {
+				t.Fatalf("incorrect issues: %v", issues)
+			}
+
+			for i, issue := range issues {
+				expected := tc.issues[i]
+				if issue.Reason != expected.Reason {
+					t.Errorf("incorrect reason: %v != %v", issue.Reason, expected.Reason)
+				}
+
+				if !equalPoints(issue.Location, expected.Location) {
+					t.Errorf("incorrect location: %v != %v", issue.Location, expected.Location)
+				}
+
+				if len(issue.Path) != 0 || len(expected.Path) != 0 {
+					if !reflect.DeepEqual(issue.Path, expected.Path) {
+						t.Errorf("incorrect path: %v != %v", issue.Path, expected.Path)
+					}
+				}
+			}
+
+			if v := Valid(tc.geom, tc.opts...); v != (len(tc.issues) == 0) {
+				t.Errorf("incorrect valid: %v", v)
+			}
+		})
+	}
+}
+
+func TestIssue_Error(t *testing.T) {
+	issue := Issue{Reason: HoleOutsideShell, Location: orb.Point{1, 2}, Path: []int{0, 1}}
+	if v := issue.Error(); v != "validate: hole outside shell at [1 2], path [0 1]" {
+		t.Errorf("incorrect error: %v", v)
+	}
+
+	if v := Reason(100).String(); v != "unknown reason 100" {
+		t.Errorf("incorrect string: %v", v)
+	}
+}
+
+func TestIssues_allGeometries(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		Issues(g, Orientation(orb.CCW))
+	}
+}
+
+func equalPoints(a, b orb.Point) bool {
+	for i := range a {
+		if a[i] != b[i] && !(math.IsNaN(a[i]) && math.IsNaN(b[i])) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestMakeValid(t *testing.T) {
+	square := orb.Ring{{0, 0}, {10, 0}, {10, 10}, {0, 10}, {0, 0}}
+
+	cases := []struct {
+		name     string
+		geom     orb.Geometry
+		area     float64
+		polygons int
+		holes    int
+	}{
+		{
+			name:     "valid",
+			geom:     orb.Polygon{square, {{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}}},
+			area:     96,
+			polygons: 1,
+			holes:    1,
+		},
+		{
+			name:     "bow-tie",
+			geom:     orb.Polygon{{{0, 0}, {2, 2}, {2, 0}, {0, 2}, {0, 0}}},
+			area:     2,
+			polygons: 2,
+		},
+		{
+			name:     "ring touches itself",
+			geom:     orb.Polygon{{{0, 0}, {4, 0}, {2, 2}, {3, 4}, {1, 4}, {2, 2}, {0, 0}}},
+			area:     6,
+			polygons: 2,
+		},
+		{
+			name:     "self-crossing ring",
+			geom:     orb.Ring{{0, 0}, {4, 0}, {4, 4}, {0, 4}, {0, 2}, {6, 2}, {6, 1}, {0, 1}, {0, 0}},
+			area:     14,
+			polygons: 3,
+		},
+		{
+			name:     "spike",
+			geom:     orb.Polygon{{{0, 0}, {4, 0}, {6, 0}, {4, 0}, {4, 4}, {0, 0}}},
+			area:     8,
+			polygons: 1,
+		},
+		{
+			name:     "clockwise and not closed",
+			geom:     orb.Polygon{{{0, 0}, {0, 1}, {1, 1}, {1, 0}}},
+			area:     1,
+			polygons: 1,
+		},
+		{
+			name:     "hole outside shell",
+			geom:     orb.Polygon{square, {{12, 2}, {12, 4}, {14, 4}, {14, 2}, {12, 2}}},
+			area:     100,
+			polygons: 1,
+		},
+		{
+			name:     "hole crosses shell",
+			geom:     orb.Polygon{square, {{8, 2}, {8, 4}, {12, 4}, {12, 2}, {8, 2}}},
+			area:     96,
+			polygons: 1,
+		},
+		{
+			name: "overlapping holes",
+			geom: orb.Polygon{
+				square,
+				{{2, 2}, {2, 4}, {4, 4}, {4, 2}, {2, 2}},
+				{{3, 3}, {3, 5}, {5, 5}, {5, 3}, {3, 3}},
+			},
+			area:     93,
+			polygons: 1,
+			holes:    1,
+		},
+		{
+			name: "overlapping polygons",
+			geom: orb.MultiPolygon{
+				{square},
+				{{{5, 5}, {15, 5}, {15, 15}, {5, 15}, {5, 5}}},
+			},
+			area:     175,
+			polygons: 1,
+		},
+		{
+			name:     "zero area",
+			geom:     orb.Polygon{{{0, 0}, {1, 1}, {2, 2}, {0, 0}}},
+			area:     0,
+			polygons: 0,
+		},
+		{
+			name: "invalid coordinates",
+			geom: orb.Polygon{
+				{{0, 0}, {1, 0}, {math.NaN(), 0}, {1, 1}, {1, 1}, {0, 1}, {0, 0}},
+			},
+			area:     1,
+			polygons: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			mp := MakeValid(tc.geom)
+
+			if a := planar.Area(mp); math.Abs(a-tc.area) > 1e-9 {
+				t.Errorf("incorrect area: %v != %v", a, tc.area)
+			}
+
+			if len(mp) != tc.polygons {
+				t.Errorf("incorrect number of polygons: %v != %v", len(mp), tc.polygons)
+			}
+
+			holes := 0
+			for _, p := range mp {
+				holes += len(p) - 1
+			}
+
+			if holes != tc.holes {
+				t.Errorf("incorrect number of holes: %v != %v", holes, tc.holes)
+			}
+
+			if issues := Issues(mp, Orientation(orb.CCW)); len(issues) != 0 {
+				t.Errorf("result not valid: %v", issues)
+			}
+		})
+	}
+}
+
+func TestMakeValid_allGeometries(t *testing.T) {
+	for _, g := range orb.AllGeometries {
+		MakeValid(g)
+	}
+}
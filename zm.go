@@ -0,0 +1,316 @@
+package orb
+
+import "math"
+
+// The geometries with z and/or m values, e.g. LineStringZ, can be marshalled
+// and unmarshalled by the encoding packages and are supported by the generic
+// functions in this package, i.e. Equal, Clone and Round. The other
+// sub-packages use the 2d version, as returned by the XY method, so
+// geometries they return do not have the extra values.
+
+// compile time checks
+var (
+	_ Geometry = PointZ{}
+	_ Geometry = MultiPointZ{}
+	_ Geometry = LineStringZ{}
+	_ Geometry = MultiLineStringZ{}
+	_ Geometry = PolygonZ{}
+	_ Geometry = MultiPolygonZ{}
+
+	_ Geometry = PointM{}
+	_ Geometry = MultiPointM{}
+	_ Geometry = LineStringM{}
+	_ Geometry = MultiLineStringM{}
+	_ Geometry = PolygonM{}
+	_ Geometry = MultiPolygonM{}
+
+	_ Geometry = PointZM{}
+	_ Geometry = MultiPointZM{}
+	_ Geometry = LineStringZM{}
+	_ Geometry = MultiLineStringZM{}
+	_ Geometry = PolygonZM{}
+	_ Geometry = MultiPolygonZM{}
+)
+
+func (p PointZ) private()              {}
+func (mp MultiPointZ) private()        {}
+func (ls LineStringZ) private()        {}
+func (mls MultiLineStringZ) private()  {}
+func (p PolygonZ) private()            {}
+func (mp MultiPolygonZ) private()      {}
+func (p PointM) private()              {}
+func (mp MultiPointM) private()        {}
+func (ls LineStringM) private()        {}
+func (mls MultiLineStringM) private()  {}
+func (p PolygonM) private()            {}
+func (mp MultiPolygonM) private()      {}
+func (p PointZM) private()             {}
+func (mp MultiPointZM) private()       {}
+func (ls LineStringZM) private()       {}
+func (mls MultiLineStringZM) private() {}
+func (p PolygonZM) private()           {}
+func (mp MultiPolygonZM) private()     {}
+
+// equalZM compares geometries with z and/or m values.
+// The second value is false if g1 is not one of those types.
+func equalZM(g1, g2 Geometry) (bool, bool) {
+	switch g1 := g1.(type) {
+	case PointZ:
+		g2, ok := g2.(PointZ)
+		return ok && g1.Equal(g2), true
+	case MultiPointZ:
+		g2, ok := g2.(MultiPointZ)
+		return ok && g1.Equal(g2), true
+	case LineStringZ:
+		g2, ok := g2.(LineStringZ)
+		return ok && g1.Equal(g2), true
+	case MultiLineStringZ:
+		g2, ok := g2.(MultiLineStringZ)
+		return ok && g1.Equal(g2), true
+	case PolygonZ:
+		g2, ok := g2.(PolygonZ)
+		return ok && g1.Equal(g2), true
+	case MultiPolygonZ:
+		g2, ok := g2.(MultiPolygonZ)
+		return ok && g1.Equal(g2), true
+	case PointM:
+		g2, ok := g2.(PointM)
+		return ok && g1.Equal(g2), true
+	case MultiPointM:
+		g2, ok := g2.(MultiPointM)
+		return ok && g1.Equal(g2), true
+	case LineStringM:
+		g2, ok := g2.(LineStringM)
+		return ok && g1.Equal(g2), true
+	case MultiLineStringM:
+		g2, ok := g2.(MultiLineStringM)
+		return ok && g1.Equal(g2), true
+	case PolygonM:
+		g2, ok := g2.(PolygonM)
+		return ok && g1.Equal(g2), true
+	case MultiPolygonM:
+		g2, ok := g2.(MultiPolygonM)
+		return ok && g1.Equal(g2), true
+	case PointZM:
+		g2, ok := g2.(PointZM)
+		return ok && g1.Equal(g2), true
+	case MultiPointZM:
+		g2, ok := g2.(MultiPointZM)
+		return ok && g1.Equal(g2), true
+	case LineStringZM:
+		g2, ok := g2.(LineStringZM)
+		return ok && g1.Equal(g2), true
+	case MultiLineStringZM:
+		g2, ok := g2.(MultiLineStringZM)
+		return ok && g1.Equal(g2), true
+	case PolygonZM:
+		g2, ok := g2.(PolygonZM)
+		return ok && g1.Equal(g2), true
+	case MultiPolygonZM:
+		g2, ok := g2.(MultiPolygonZM)
+		return ok && g1.Equal(g2), true
+	}
+
+	return false, false
+}
+
+// cloneZM copies geometries with z and/or m values.
+// The second value is false if g is not one of those types.
+func cloneZM(g Geometry) (Geometry, bool) {
+	switch g := g.(type) {
+	case PointZ:
+		return g, true
+	case MultiPointZ:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case LineStringZ:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case MultiLineStringZ:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case PolygonZ:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case MultiPolygonZ:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case PointM:
+		return g, true
+	case MultiPointM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case LineStringM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case MultiLineStringM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case PolygonM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case MultiPolygonM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case PointZM:
+		return g, true
+	case MultiPointZM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case LineStringZM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case MultiLineStringZM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case PolygonZM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	case MultiPolygonZM:
+		if g == nil {
+			return nil, true
+		}
+		return g.Clone(), true
+	}
+
+	return nil, false
+}
+
+// roundZM rounds all the values, including z and m, of the geometry.
+// The second value is false if g is not one of those types.
+func roundZM(g Geometry, f float64) (Geometry, bool) {
+	switch g := g.(type) {
+	case PointZ:
+		return roundPointZ(g, f), true
+	case PointM:
+		return roundPointM(g, f), true
+	case PointZM:
+		return roundPointZM(g, f), true
+	case MultiPointZ:
+		for i := range g {
+			g[i] = roundPointZ(g[i], f)
+		}
+		return g, true
+	case LineStringZ:
+		for i := range g {
+			g[i] = roundPointZ(g[i], f)
+		}
+		return g, true
+	case MultiLineStringZ:
+		for _, ls := range g {
+			roundZM(ls, f)
+		}
+		return g, true
+	case PolygonZ:
+		for _, r := range g {
+			roundZM(r, f)
+		}
+		return g, true
+	case MultiPolygonZ:
+		for _, p := range g {
+			roundZM(p, f)
+		}
+		return g, true
+	case MultiPointM:
+		for i := range g {
+			g[i] = roundPointM(g[i], f)
+		}
+		return g, true
+	case LineStringM:
+		for i := range g {
+			g[i] = roundPointM(g[i], f)
+		}
+		return g, true
+	case MultiLineStringM:
+		for _, ls := range g {
+			roundZM(ls, f)
+		}
+		return g, true
+	case PolygonM:
+		for _, r := range g {
+			roundZM(r, f)
+		}
+		return g, true
+	case MultiPolygonM:
+		for _, p := range g {
+			roundZM(p, f)
+		}
+		return g, true
+	case MultiPointZM:
+		for i := range g {
+			g[i] = roundPointZM(g[i], f)
+		}
+		return g, true
+	case LineStringZM:
+		for i := range g {
+			g[i] = roundPointZM(g[i], f)
+		}
+		return g, true
+	case MultiLineStringZM:
+		for _, ls := range g {
+			roundZM(ls, f)
+		}
+		return g, true
+	case PolygonZM:
+		for _, r := range g {
+			roundZM(r, f)
+		}
+		return g, true
+	case MultiPolygonZM:
+		for _, p := range g {
+			roundZM(p, f)
+		}
+		return g, true
+	}
+
+	return nil, false
+}
+
+func roundPointZ(p PointZ, f float64) PointZ {
+	for i := range p {
+		p[i] = math.Round(p[i]*f) / f
+	}
+	return p
+}
+
+func roundPointM(p PointM, f float64) PointM {
+	for i := range p {
+		p[i] = math.Round(p[i]*f) / f
+	}
+	return p
+}
+
+func roundPointZM(p PointZM, f float64) PointZM {
+	for i := range p {
+		p[i] = math.Round(p[i]*f) / f
+	}
+	return p
+}
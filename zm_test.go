@@ -0,0 +1,101 @@
+package orb
+
+import (
+	"fmt"
+	"testing"
+)
+
+var allZMGeometries = []Geometry{
+	PointZ{1, 2, 3},
+	MultiPointZ{{1, 2, 3}},
+	LineStringZ{{1, 2, 3}, {4, 5, 6}},
+	MultiLineStringZ{{{1, 2, 3}}},
+	PolygonZ{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}},
+	MultiPolygonZ{{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}}},
+	PointM{1, 2, 3},
+	MultiPointM{{1, 2, 3}},
+	LineStringM{{1, 2, 3}, {4, 5, 6}},
+	MultiLineStringM{{{1, 2, 3}}},
+	PolygonM{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}},
+	MultiPolygonM{{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}}},
+	PointZM{1, 2, 3, 4},
+	MultiPointZM{{1, 2, 3, 4}},
+	LineStringZM{{1, 2, 3, 4}, {5, 6, 7, 8}},
+	MultiLineStringZM{{{1, 2, 3, 4}}},
+	PolygonZM{{{0, 0, 1, 2}, {1, 0, 2, 3}, {1, 1, 3, 4}, {0, 0, 1, 2}}},
+	MultiPolygonZM{{{{0, 0, 1, 2}, {1, 0, 2, 3}, {1, 1, 3, 4}, {0, 0, 1, 2}}}},
+}
+
+func TestZM(t *testing.T) {
+	for _, g := range allZMGeometries {
+		t.Run(fmt.Sprintf("%T", g), func(t *testing.T) {
+			if !Equal(g, g) {
+				t.Errorf("should be equal to itself")
+			}
+
+			if !Equal(Clone(g), g) {
+				t.Errorf("clone should be equal")
+			}
+
+			if !Equal(Round(g), g) {
+				t.Errorf("round should not change integers")
+			}
+		})
+	}
+
+	// different layouts are not equal, even with the same values
+	if Equal(PointZ{1, 2, 3}, PointM{1, 2, 3}) {
+		t.Errorf("should not be equal")
+	}
+
+	if Equal(Point{1, 2}, PointZ{1, 2, 0}) {
+		t.Errorf("should not be equal")
+	}
+
+	if Equal(LineStringZ{{1, 2, 3}}, LineString{{1, 2}}) {
+		t.Errorf("should not be equal")
+	}
+}
+
+func TestZM_XY(t *testing.T) {
+	if p := (PointZM{1, 2, 3, 4}).XY(); !p.Equal(Point{1, 2}) {
+		t.Errorf("incorrect point: %v", p)
+	}
+
+	ls := LineStringZ{{1, 2, 3}, {4, 5, 6}}
+	if xy := ls.XY(); !xy.Equal(LineString{{1, 2}, {4, 5}}) {
+		t.Errorf("incorrect line string: %v", xy)
+	}
+
+	p := PolygonM{{{0, 0, 1}, {1, 0, 2}, {1, 1, 3}, {0, 0, 1}}}
+	if xy := p.XY(); !xy.Equal(Polygon{{{0, 0}, {1, 0}, {1, 1}, {0, 0}}}) {
+		t.Errorf("incorrect polygon: %v", xy)
+	}
+
+	if b := ls.Bound(); !b.Equal(Bound{Min: Point{1, 2}, Max: Point{4, 5}}) {
+		t.Errorf("incorrect bound: %v", b)
+	}
+
+	if (LineStringZ)(nil).XY() != nil {
+		t.Errorf("nil should stay nil")
+	}
+}
+
+func TestZM_Round(t *testing.T) {
+	g := Round(LineStringZM{{0.007, -0.007, 1.234, 5.678}}, 1e2)
+
+	expected := LineStringZM{{0.01, -0.01, 1.23, 5.68}}
+	if !Equal(g, expected) {
+		t.Errorf("incorrect round: %v", g)
+	}
+}
+
+func TestZM_Clone(t *testing.T) {
+	ls := LineStringZ{{1, 2, 3}}
+	c := Clone(ls).(LineStringZ)
+	c[0][2] = 10
+
+	if ls[0][2] != 3 {
+		t.Errorf("clone should be a deep copy")
+	}
+}